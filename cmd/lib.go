@@ -20,7 +20,7 @@ func registerCommands(s *mcp.Server, cl *tools.Client) {
 	// The unified tools use action-based organization:
 	// - gitea_manual: On-demand documentation lookup
 	// - create_gitea: Create resources (issue, label, milestone, release, wiki_page, pull_request, issue_comment)
-	// - get_gitea: Get single resources (issue, wiki_page, pull_request, repository)
+	// - get_gitea: Get single resources (issue, wiki_page, pull_request, release, repository)
 	// - list_gitea: List resources (issues, labels, milestones, releases, wiki_pages, pull_requests, repositories, etc.)
 	// - edit_gitea: Edit resources (issue, label, milestone, release, wiki_page, etc.)
 	// - delete_gitea: Delete resources (label, milestone, release, wiki_page, issue_comment, etc.)
@@ -8,7 +8,9 @@ package tools
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -21,6 +23,32 @@ import (
 
 var UserAgent = "Forgejo-MCP/" + types.VERSION
 
+// HTTPError is returned by the requests of custom endpoints when the server
+// answers with an error status.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Status)
+}
+
+// unsupportedAPI explains a 404 or 405 from an endpoint only some server
+// versions provide; other errors are returned unchanged.
+// api: what the endpoint belongs to, like "the Actions artifacts API"
+// requirement: the servers providing it
+func unsupportedAPI(err error, api, requirement string) error {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		return err
+	}
+	if httpErr.StatusCode != http.StatusNotFound && httpErr.StatusCode != http.StatusMethodNotAllowed {
+		return err
+	}
+	return fmt.Errorf("%w: this server does not expose %s (needs %s), or the requested item does not exist", err, api, requirement)
+}
+
 // Client wraps the Forgejo SDK client with additional functionality for
 // unsupported API endpoints. It provides methods for JSON requests and
 // multipart file uploads with manual authentication.
@@ -75,8 +103,14 @@ func NewClient(base, token, version string, cl *http.Client) (*Client, error) {
 // method: HTTP method (GET, POST, PATCH, DELETE)
 // endpoint: API endpoint path (relative to base URL)
 // paramObj: request parameter object (JSON serialized), can be nil for GET/DELETE
-// respObj: response data receiver object (JSON deserialized)
+// respObj: response data receiver object (JSON deserialized), can be nil to discard the response
 func (c *Client) sendSimpleRequest(method, endpoint string, paramObj, respObj any) error {
+	return c.sendSimpleRequestContext(context.Background(), method, endpoint, paramObj, respObj)
+}
+
+// sendSimpleRequestContext is sendSimpleRequest bound to ctx, so long running
+// operations stop as soon as the caller gives up.
+func (c *Client) sendSimpleRequestContext(ctx context.Context, method, endpoint string, paramObj, respObj any) error {
 	// Build complete URL
 	u, err := url.Parse(c.base + endpoint)
 	if err != nil {
@@ -94,7 +128,7 @@ func (c *Client) sendSimpleRequest(method, endpoint string, paramObj, respObj an
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequest(method, u.String(), body)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -119,17 +153,66 @@ func (c *Client) sendSimpleRequest(method, endpoint string, paramObj, respObj an
 
 	// Check HTTP status
 	if resp.StatusCode >= 400 {
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
+		return &HTTPError{StatusCode: resp.StatusCode, Status: resp.Status}
 	}
 
-	// Parse JSON response
-	if err := json.NewDecoder(resp.Body).Decode(respObj); err != nil {
+	// Parse JSON response, endpoints like PUT/DELETE may answer without body
+	if respObj == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(respObj); err != nil && err != io.EOF {
 		return fmt.Errorf("failed to decode response: %w", err)
 	}
 
 	return nil
 }
 
+// sendDownloadRequest handles binary GET requests such as archive downloads
+// endpoint: API endpoint path (relative to base URL)
+// maxBytes: maximum accepted response size, larger responses are rejected
+// Redirects are followed by the underlying HTTP client.
+func (c *Client) sendDownloadRequest(endpoint string, maxBytes int64) ([]byte, error) {
+	// Build complete URL
+	u, err := url.Parse(c.base + endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	// Create HTTP request
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Set authentication header manually
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+
+	// Send request
+	resp, err := c.cl.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Check HTTP status
+	if resp.StatusCode >= 400 {
+		return nil, &HTTPError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+
+	// Read at most maxBytes+1 so oversized responses can be detected
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("response exceeds %d bytes", maxBytes)
+	}
+
+	return data, nil
+}
+
 // sendUploadRequest handles file upload requests (multipart/form-data)
 // endpoint: API endpoint path (fixed to use POST)
 // filename: upload file name
@@ -192,7 +275,7 @@ func (c *Client) sendUploadRequest(endpoint, filename string, file io.Reader, ex
 
 	// Check HTTP status
 	if resp.StatusCode >= 400 {
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, resp.Status)
+		return &HTTPError{StatusCode: resp.StatusCode, Status: resp.Status}
 	}
 
 	// Parse JSON response
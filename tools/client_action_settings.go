@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package tools
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// ActionScope is the API path prefix of the owner of Forgejo Actions settings
+// such as secrets and variables. Build one with RepoActionScope or
+// OrgActionScope, or use UserActionScope or InstanceActionScope.
+type ActionScope string
+
+// UserActionScope refers to settings of the authenticated user.
+const UserActionScope ActionScope = "/user"
+
+// InstanceActionScope refers to instance-wide settings, which are only
+// available to site administrators. Only runners support this scope.
+const InstanceActionScope ActionScope = "/admin"
+
+// RepoActionScope refers to settings of a repository.
+func RepoActionScope(owner, repo string) ActionScope {
+	return ActionScope(fmt.Sprintf("/repos/%s/%s", owner, repo))
+}
+
+// OrgActionScope refers to settings of an organization.
+func OrgActionScope(org string) ActionScope {
+	return ActionScope("/orgs/" + org)
+}
+
+// MyListActionVariables lists all Actions variables of a scope.
+// GET {scope}/actions/variables
+func (c *Client) MyListActionVariables(scope ActionScope) ([]*types.MyActionVariable, error) {
+	endpoint := fmt.Sprintf("/api/v1%s/actions/variables", scope)
+
+	var result []*types.MyActionVariable
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// MyGetActionVariable gets a single Actions variable, including its value.
+// GET {scope}/actions/variables/{name}
+func (c *Client) MyGetActionVariable(scope ActionScope, name string) (*types.MyActionVariable, error) {
+	endpoint := fmt.Sprintf("/api/v1%s/actions/variables/%s", scope, url.PathEscape(name))
+
+	var result types.MyActionVariable
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// MyCreateActionVariable creates an Actions variable.
+// POST {scope}/actions/variables/{name}
+func (c *Client) MyCreateActionVariable(scope ActionScope, name string, options types.MyActionVariableOption) error {
+	endpoint := fmt.Sprintf("/api/v1%s/actions/variables/%s", scope, url.PathEscape(name))
+	return c.sendSimpleRequest("POST", endpoint, options, nil)
+}
+
+// MyUpdateActionVariable updates the value (and optionally the name) of an
+// Actions variable.
+// PUT {scope}/actions/variables/{name}
+func (c *Client) MyUpdateActionVariable(scope ActionScope, name string, options types.MyActionVariableOption) error {
+	endpoint := fmt.Sprintf("/api/v1%s/actions/variables/%s", scope, url.PathEscape(name))
+	return c.sendSimpleRequest("PUT", endpoint, options, nil)
+}
+
+// MyDeleteActionVariable deletes an Actions variable.
+// DELETE {scope}/actions/variables/{name}
+func (c *Client) MyDeleteActionVariable(scope ActionScope, name string) error {
+	endpoint := fmt.Sprintf("/api/v1%s/actions/variables/%s", scope, url.PathEscape(name))
+	return c.sendSimpleRequest("DELETE", endpoint, nil, nil)
+}
+
+// MyListActionSecrets lists the names of the Actions secrets of a scope.
+// Forgejo offers this for repositories and organizations only.
+// GET {scope}/actions/secrets
+func (c *Client) MyListActionSecrets(scope ActionScope, page, limit int) ([]*types.MyActionSecret, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("limit", strconv.Itoa(limit))
+	endpoint := fmt.Sprintf("/api/v1%s/actions/secrets?%s", scope, query.Encode())
+
+	var result []*types.MyActionSecret
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// MyPutActionSecret creates or replaces an Actions secret. Secret values are
+// write-only: the API offers no way to read them back.
+// PUT {scope}/actions/secrets/{name}
+func (c *Client) MyPutActionSecret(scope ActionScope, name, value string) error {
+	endpoint := fmt.Sprintf("/api/v1%s/actions/secrets/%s", scope, url.PathEscape(name))
+	return c.sendSimpleRequest("PUT", endpoint, types.MyActionSecretOption{Data: value}, nil)
+}
+
+// MyDeleteActionSecret deletes an Actions secret.
+// DELETE {scope}/actions/secrets/{name}
+func (c *Client) MyDeleteActionSecret(scope ActionScope, name string) error {
+	endpoint := fmt.Sprintf("/api/v1%s/actions/secrets/%s", scope, url.PathEscape(name))
+	return c.sendSimpleRequest("DELETE", endpoint, nil, nil)
+}
+
+// runnerTokenEndpoint returns the registration token endpoint of a scope.
+// The instance-wide endpoint lives outside of the actions namespace.
+func runnerTokenEndpoint(scope ActionScope) string {
+	if scope == InstanceActionScope {
+		return "/api/v1/admin/runners/registration-token"
+	}
+	return fmt.Sprintf("/api/v1%s/actions/runners/registration-token", scope)
+}
+
+// MyGetRunnerRegistrationToken gets the current runner registration token of
+// a scope.
+// GET {scope}/actions/runners/registration-token
+func (c *Client) MyGetRunnerRegistrationToken(scope ActionScope) (*types.MyRunnerRegistrationToken, error) {
+	var result types.MyRunnerRegistrationToken
+	err := c.sendSimpleRequest("GET", runnerTokenEndpoint(scope), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
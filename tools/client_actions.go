@@ -7,21 +7,105 @@
 package tools
 
 import (
+	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 
 	"github.com/raohwork/forgejo-mcp/types"
 )
 
+// MaxArtifactSize is the largest artifact archive MyDownloadActionArtifact
+// accepts. Artifacts are held in memory while being inspected.
+const MaxArtifactSize = 64 << 20
+
 // MyListActionTasks lists all Forgejo Actions tasks in a repository.
 // GET /repos/{owner}/{repo}/actions/tasks
 func (c *Client) MyListActionTasks(owner, repo string) (*types.MyActionTaskResponse, error) {
+	return c.MyListActionTasksContext(context.Background(), owner, repo, 0, 0)
+}
+
+// MyListActionTasksContext lists Forgejo Actions tasks in a repository, newest
+// first. Zero page or limit leaves the server default.
+// GET /repos/{owner}/{repo}/actions/tasks
+func (c *Client) MyListActionTasksContext(ctx context.Context, owner, repo string, page, limit int) (*types.MyActionTaskResponse, error) {
 	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/actions/tasks", owner, repo)
+	query := url.Values{}
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
 
 	var result types.MyActionTaskResponse
-	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	err := c.sendSimpleRequestContext(ctx, "GET", endpoint, nil, &result)
 	if err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
+
+// The artifact endpoints are missing from older servers, see unsupportedAPI.
+const (
+	artifactsAPI         = "the Actions artifacts API"
+	artifactsRequirement = "Gitea 1.23 or later; Forgejo up to 11 does not provide it"
+)
+
+// MyListActionArtifacts lists all Forgejo Actions artifacts in a repository.
+// GET /repos/{owner}/{repo}/actions/artifacts
+func (c *Client) MyListActionArtifacts(owner, repo string) (*types.MyActionArtifactResponse, error) {
+	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/actions/artifacts", owner, repo)
+
+	var result types.MyActionArtifactResponse
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, unsupportedAPI(err, artifactsAPI, artifactsRequirement)
+	}
+
+	return &result, nil
+}
+
+// MyListActionRunArtifacts lists the artifacts produced by a single workflow run.
+// GET /repos/{owner}/{repo}/actions/runs/{run}/artifacts
+func (c *Client) MyListActionRunArtifacts(owner, repo string, run int64) (*types.MyActionArtifactResponse, error) {
+	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/actions/runs/%d/artifacts", owner, repo, run)
+
+	var result types.MyActionArtifactResponse
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, unsupportedAPI(err, artifactsAPI, artifactsRequirement)
+	}
+
+	return &result, nil
+}
+
+// MyGetActionArtifact gets a single artifact by ID.
+// GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}
+func (c *Client) MyGetActionArtifact(owner, repo string, id int64) (*types.MyActionArtifact, error) {
+	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/actions/artifacts/%d", owner, repo, id)
+
+	var result types.MyActionArtifact
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, unsupportedAPI(err, artifactsAPI, artifactsRequirement)
+	}
+
+	return &result, nil
+}
+
+// MyDownloadActionArtifact downloads the zip archive of an artifact.
+// Archives larger than MaxArtifactSize are rejected.
+// GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}/zip
+func (c *Client) MyDownloadActionArtifact(owner, repo string, id int64) ([]byte, error) {
+	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/actions/artifacts/%d/zip", owner, repo, id)
+	data, err := c.sendDownloadRequest(endpoint, MaxArtifactSize)
+	if err != nil {
+		return nil, unsupportedAPI(err, artifactsAPI, artifactsRequirement)
+	}
+	return data, nil
+}
@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package tools
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// MySearchIssues searches issues and pull requests in every repository the
+// authenticated user can access.
+// GET /repos/issues/search
+func (c *Client) MySearchIssues(opt types.MyIssueSearchOption) ([]*forgejo.Issue, error) {
+	query := url.Values{}
+	set := func(key, value string) {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	flag := func(key string, value bool) {
+		if value {
+			query.Set(key, "true")
+		}
+	}
+
+	set("type", opt.Type)
+	set("state", opt.State)
+	set("labels", strings.Join(opt.Labels, ","))
+	set("milestones", strings.Join(opt.Milestones, ","))
+	set("q", opt.Query)
+	flag("assigned", opt.Assigned)
+	flag("created", opt.Created)
+	flag("mentioned", opt.Mentioned)
+	flag("review_requested", opt.ReviewRequested)
+	set("owner", opt.Owner)
+	set("team", opt.Team)
+	if !opt.Since.IsZero() {
+		query.Set("since", opt.Since.Format(time.RFC3339))
+	}
+	if opt.Page > 0 {
+		query.Set("page", strconv.Itoa(opt.Page))
+	}
+	if opt.Limit > 0 {
+		query.Set("limit", strconv.Itoa(opt.Limit))
+	}
+
+	endpoint := "/api/v1/repos/issues/search"
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var result []*forgejo.Issue
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package tools
+
+import (
+	"fmt"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// MyGetIssue gets an issue including fields missing from the SDK, such as
+// the pin order.
+// GET /repos/{owner}/{repo}/issues/{index}
+func (c *Client) MyGetIssue(owner, repo string, index int64) (*types.MyIssue, error) {
+	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/issues/%d", owner, repo, index)
+
+	var result types.MyIssue
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// MyPinIssue pins an issue to the top of the issue list.
+// POST /repos/{owner}/{repo}/issues/{index}/pin
+func (c *Client) MyPinIssue(owner, repo string, index int64) error {
+	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/issues/%d/pin", owner, repo, index)
+	return c.sendSimpleRequest("POST", endpoint, nil, nil)
+}
+
+// MyUnpinIssue unpins an issue.
+// DELETE /repos/{owner}/{repo}/issues/{index}/pin
+func (c *Client) MyUnpinIssue(owner, repo string, index int64) error {
+	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/issues/%d/pin", owner, repo, index)
+	return c.sendSimpleRequest("DELETE", endpoint, nil, nil)
+}
+
+// MyListPinnedIssues lists the pinned issues of a repository.
+// GET /repos/{owner}/{repo}/issues/pinned
+func (c *Client) MyListPinnedIssues(owner, repo string) ([]*forgejo.Issue, error) {
+	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/issues/pinned", owner, repo)
+
+	var result []*forgejo.Issue
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package tools
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// MyListIssueTimeline lists every event of an issue or pull request,
+// comments included. Zero since/before are not sent.
+// GET /repos/{owner}/{repo}/issues/{index}/timeline
+func (c *Client) MyListIssueTimeline(owner, repo string, index int64, page, limit int, since, before time.Time) ([]*types.MyTimelineComment, error) {
+	query := url.Values{}
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if !since.IsZero() {
+		query.Set("since", since.Format(time.RFC3339))
+	}
+	if !before.IsZero() {
+		query.Set("before", before.Format(time.RFC3339))
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/issues/%d/timeline", owner, repo, index)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var result []*types.MyTimelineComment
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
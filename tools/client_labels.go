@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package tools
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// LabelScope is the API path prefix of the owner of labels: a repository or
+// an organization. Build one with RepoLabelScope or OrgLabelScope.
+type LabelScope string
+
+// RepoLabelScope refers to the labels of a repository.
+func RepoLabelScope(owner, repo string) LabelScope {
+	return LabelScope(fmt.Sprintf("/repos/%s/%s", owner, repo))
+}
+
+// OrgLabelScope refers to the labels of an organization, which every
+// repository of the organization can use.
+func OrgLabelScope(org string) LabelScope {
+	return LabelScope("/orgs/" + org)
+}
+
+// MyListLabels lists a page of labels of a scope, including the exclusive
+// flag the SDK leaves out.
+// GET {scope}/labels
+func (c *Client) MyListLabels(scope LabelScope, page, limit int) ([]*types.MyLabel, error) {
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(page))
+	query.Set("limit", strconv.Itoa(limit))
+	endpoint := fmt.Sprintf("/api/v1%s/labels?%s", scope, query.Encode())
+
+	var result []*types.MyLabel
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// MyCreateLabel creates a label in a scope.
+// POST {scope}/labels
+func (c *Client) MyCreateLabel(scope LabelScope, options types.MyCreateLabelOption) (*types.MyLabel, error) {
+	endpoint := fmt.Sprintf("/api/v1%s/labels", scope)
+
+	var result types.MyLabel
+	err := c.sendSimpleRequest("POST", endpoint, options, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// MyEditLabel edits a label of a scope.
+// PATCH {scope}/labels/{id}
+func (c *Client) MyEditLabel(scope LabelScope, id int64, options types.MyEditLabelOption) (*types.MyLabel, error) {
+	endpoint := fmt.Sprintf("/api/v1%s/labels/%d", scope, id)
+
+	var result types.MyLabel
+	err := c.sendSimpleRequest("PATCH", endpoint, options, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// MyDeleteLabel deletes a label of a scope.
+// DELETE {scope}/labels/{id}
+func (c *Client) MyDeleteLabel(scope LabelScope, id int64) error {
+	endpoint := fmt.Sprintf("/api/v1%s/labels/%d", scope, id)
+	return c.sendSimpleRequest("DELETE", endpoint, nil, nil)
+}
@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package tools
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// MyListPullRequests lists pull requests of a repository, filtered by
+// labels as well.
+// GET /repos/{owner}/{repo}/pulls
+func (c *Client) MyListPullRequests(owner, repo string, opt types.MyPullRequestListOption) ([]*forgejo.PullRequest, error) {
+	query := url.Values{}
+	if opt.State != "" {
+		query.Set("state", opt.State)
+	}
+	if opt.Sort != "" {
+		query.Set("sort", opt.Sort)
+	}
+	if opt.Milestone > 0 {
+		query.Set("milestone", strconv.FormatInt(opt.Milestone, 10))
+	}
+	for _, id := range opt.Labels {
+		query.Add("labels", strconv.FormatInt(id, 10))
+	}
+	if opt.Page > 0 {
+		query.Set("page", strconv.Itoa(opt.Page))
+	}
+	if opt.Limit > 0 {
+		query.Set("limit", strconv.Itoa(opt.Limit))
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/repos/%s/%s/pulls", owner, repo)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var result []*forgejo.PullRequest
+	err := c.sendSimpleRequest("GET", endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
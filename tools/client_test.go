@@ -7,12 +7,15 @@
 package tools
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
+	"time"
 )
 
 const forgejo_version_to_test = "11.0.1+gitea-1.22.0"
@@ -151,6 +154,55 @@ func TestClient_sendSimpleRequest(t *testing.T) {
 		}
 	})
 
+	// Empty response test - PUT/DELETE endpoints answering 201/204 without body
+	t.Run("empty_response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "PUT" {
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client, err := NewClient(server.URL, "test-token", forgejo_version_to_test, server.Client())
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+
+		var result map[string]interface{}
+		if err := client.sendSimpleRequest("DELETE", "/api/v1/repos/owner/repo/actions/secrets/TOKEN", nil, &result); err != nil {
+			t.Errorf("Expected no error for 204 response, got %v", err)
+		}
+		if err := client.sendSimpleRequest("PUT", "/api/v1/repos/owner/repo/actions/secrets/TOKEN", map[string]string{"data": "x"}, &result); err != nil {
+			t.Errorf("Expected no error for empty 201 response, got %v", err)
+		}
+		if err := client.sendSimpleRequest("PUT", "/api/v1/repos/owner/repo/actions/secrets/TOKEN", map[string]string{"data": "x"}, nil); err != nil {
+			t.Errorf("Expected no error with nil respObj, got %v", err)
+		}
+	})
+
+	t.Run("context_cancelled", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}))
+		defer server.Close()
+
+		client, err := NewClient(server.URL, "test-token", forgejo_version_to_test, server.Client())
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		var result map[string]interface{}
+		err = client.sendSimpleRequestContext(ctx, "GET", "/api/v1/repos/owner/repo/actions/tasks", nil, &result)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected deadline exceeded error, got %v", err)
+		}
+	})
+
 	// JSON parsing error test
 	t.Run("JSON_parse_error", func(t *testing.T) {
 		// Mock server returning invalid JSON
@@ -174,6 +226,61 @@ func TestClient_sendSimpleRequest(t *testing.T) {
 	})
 }
 
+// sendDownloadRequest Specification:
+//
+// Responsibility: Download binary payloads such as Actions artifact archives
+//
+// Business Logic:
+// 1. Create HTTP GET request for the endpoint
+// 2. Add authentication header
+// 3. Follow redirects (handled by http.Client)
+// 4. Return the raw body, rejecting bodies larger than maxBytes
+func TestClient_sendDownloadRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/repos/owner/repo/actions/artifacts/1/zip":
+			if r.Header.Get("Authorization") != "token test-token" {
+				t.Errorf("Expected token authentication, got %q", r.Header.Get("Authorization"))
+			}
+			http.Redirect(w, r, "/blob/1", http.StatusFound)
+		case "/blob/1":
+			w.Write([]byte("PK-binary-data"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "test-token", forgejo_version_to_test, server.Client())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	t.Run("follows_redirect", func(t *testing.T) {
+		data, err := client.sendDownloadRequest("/api/v1/repos/owner/repo/actions/artifacts/1/zip", 1024)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if string(data) != "PK-binary-data" {
+			t.Errorf("Unexpected body %q", data)
+		}
+	})
+
+	t.Run("too_large", func(t *testing.T) {
+		_, err := client.sendDownloadRequest("/api/v1/repos/owner/repo/actions/artifacts/1/zip", 4)
+		if err == nil {
+			t.Error("Expected error for oversized body, got nil")
+		}
+	})
+
+	t.Run("HTTP_error", func(t *testing.T) {
+		_, err := client.sendDownloadRequest("/api/v1/repos/owner/repo/actions/artifacts/2/zip", 1024)
+		if err == nil {
+			t.Error("Expected error for 404 response, got nil")
+		}
+	})
+}
+
 // sendUploadRequest Specification:
 //
 // Responsibility: Handle file upload requests, currently mainly for Issue Attachment creation
@@ -0,0 +1,248 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/tools"
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+const (
+	defaultBulkConcurrency = 4
+	maxBulkConcurrency     = 8
+	// bulkMaxIssues bounds the issues a single bulk call may touch.
+	bulkMaxIssues = 200
+	// bulkPageSize is the page size used when resolving a filter.
+	bulkPageSize = 50
+)
+
+// bulkIssueFunc applies a change to one issue and returns a short detail
+// shown in the result table.
+type bulkIssueFunc func(index int64) (string, error)
+
+// isBulk reports whether the arguments select issues by indexes or filter
+// instead of a single index.
+func isBulk(args map[string]any) bool {
+	_, hasIndexes := args["indexes"].([]any)
+	_, hasFilter := args["filter"].(map[string]any)
+	return hasIndexes || hasFilter
+}
+
+// bulkIssueIndexes resolves the issues of a bulk operation, either from the
+// indexes argument or by listing the issues matching filter, which accepts
+// the same parameters as list:issue.
+func bulkIssueIndexes(client *tools.Client, owner, repo string, args map[string]any) ([]int64, error) {
+	if raw, ok := args["indexes"].([]any); ok {
+		var indexes []int64
+		for _, index := range toInt64Slice(raw) {
+			if index > 0 && !slices.Contains(indexes, index) {
+				indexes = append(indexes, index)
+			}
+		}
+		if len(indexes) == 0 {
+			return nil, errors.New("indexes must contain at least one issue number")
+		}
+		if len(indexes) > bulkMaxIssues {
+			return nil, fmt.Errorf("at most %d issues can be changed at once", bulkMaxIssues)
+		}
+		return indexes, nil
+	}
+
+	filter, ok := args["filter"].(map[string]any)
+	if !ok {
+		return nil, errors.New("index, indexes or filter is required")
+	}
+	for _, key := range []string{"page", "limit"} {
+		if _, ok := filter[key]; ok {
+			return nil, fmt.Errorf("filter does not accept %s: every matching issue is changed", key)
+		}
+	}
+	opt, err := issueListOptions(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// pull requests are issues to the API, but not to a bulk change
+	var indexes []int64
+	opt.Type = forgejo.IssueTypeIssue
+	opt.PageSize = bulkPageSize
+	for opt.Page = 1; ; opt.Page++ {
+		issues, _, err := client.ListRepoIssues(owner, repo, opt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues: %w", err)
+		}
+		for _, issue := range issues {
+			indexes = append(indexes, issue.Index)
+		}
+		if len(indexes) > bulkMaxIssues {
+			return nil, fmt.Errorf("filter matches more than %d issues, narrow it down", bulkMaxIssues)
+		}
+		if len(issues) < bulkPageSize {
+			return indexes, nil
+		}
+	}
+}
+
+// runIssueBulk applies fn to every issue with bounded concurrency. Issues
+// not started before ctx is done are reported as failed.
+func runIssueBulk(ctx context.Context, indexes []int64, concurrency int, fn bulkIssueFunc) types.BulkIssueResultList {
+	results := make(types.BulkIssueResultList, len(indexes))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, index := range indexes {
+		results[i] = &types.BulkIssueResult{Index: index}
+		select {
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(r *types.BulkIssueResult) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := ctx.Err(); err != nil {
+				r.Err = err
+				return
+			}
+			r.Detail, r.Err = fn(r.Index)
+		}(results[i])
+	}
+	wg.Wait()
+	return results
+}
+
+// bulkConcurrency reads the concurrency argument, bounded by
+// maxBulkConcurrency.
+func bulkConcurrency(args map[string]any) int {
+	if n, ok := args["concurrency"].(float64); ok && n >= 1 {
+		return min(int(n), maxBulkConcurrency)
+	}
+	return defaultBulkConcurrency
+}
+
+// issueBulk resolves the selected issues and runs fn on them.
+func issueBulk(ctx context.Context, client *tools.Client, action Action, resource string, args map[string]any, fn bulkIssueFunc) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(action, resource, err.Error()))
+	}
+	indexes, err := bulkIssueIndexes(client, owner, repo, args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(action, resource, err.Error()))
+	}
+
+	results := runIssueBulk(ctx, indexes, bulkConcurrency(args), fn)
+	return textResult(results.ToMarkdown()), nil, nil
+}
+
+// addLabelsFunc adds labels to an issue and reports its resulting labels,
+// and those replaced in the same scope.
+func addLabelsFunc(client *tools.Client, owner, repo string, labelIDs []int64, replaceScoped bool) bulkIssueFunc {
+	return func(index int64) (string, error) {
+		labels, replaced, err := addIssueLabelsScoped(client, owner, repo, index, labelIDs, replaceScoped)
+		if err != nil {
+			return "", err
+		}
+		detail := "labels " + labelNames(labels)
+		if len(replaced) > 0 {
+			detail += "; replaced " + labelNames(replaced)
+		}
+		return detail, nil
+	}
+}
+
+// removeLabelsFunc removes labels from an issue.
+func removeLabelsFunc(client *tools.Client, owner, repo string, labelIDs []int64) bulkIssueFunc {
+	return func(index int64) (string, error) {
+		for _, id := range labelIDs {
+			if _, err := client.DeleteIssueLabel(owner, repo, index, id); err != nil {
+				return "", err
+			}
+		}
+		return fmt.Sprintf("%d labels removed", len(labelIDs)), nil
+	}
+}
+
+// editIssueBulk applies exactly one change to many issues: a new state,
+// milestone or assignees, or adding or removing labels.
+func (impl EditImpl) editIssueBulk(ctx context.Context, args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue_bulk", err.Error()))
+	}
+
+	var (
+		fn      bulkIssueFunc
+		changes int
+		opt     forgejo.EditIssueOption
+	)
+	if state, ok := args["state"].(string); ok && state != "" {
+		if state != "open" && state != "closed" {
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue_bulk", "state must be 'open' or 'closed'"))
+		}
+		s := forgejo.StateType(state)
+		opt.State = &s
+		changes++
+	}
+	milestone, ok, err := resolveMilestoneID(impl.Client, owner, repo, args["milestone"])
+	if err != nil {
+		return nil, nil, resolveError(ActionEdit, "issue_bulk", err)
+	}
+	if ok && milestone >= 0 {
+		opt.Milestone = &milestone
+		changes++
+	}
+	if assignees, ok := args["assignees"].([]any); ok {
+		if opt.Assignees, err = resolveAssignees(impl.Client, owner, repo, assignees); err != nil {
+			return nil, nil, resolveError(ActionEdit, "issue_bulk", err)
+		}
+		changes++
+	}
+	if changes > 0 {
+		fn = func(index int64) (string, error) {
+			issue, _, err := impl.Client.EditIssue(owner, repo, index, opt)
+			if err != nil {
+				return "", err
+			}
+			return issue.Title, nil
+		}
+	}
+	if labels, ok := args["add_labels"].([]any); ok && len(labels) > 0 {
+		ids, err := resolveLabelIDs(impl.Client, owner, repo, labels)
+		if err != nil {
+			return nil, nil, resolveError(ActionEdit, "issue_bulk", err)
+		}
+		replaceScoped, _ := args["replace_scoped"].(bool)
+		fn = addLabelsFunc(impl.Client, owner, repo, ids, replaceScoped)
+		changes++
+	}
+	if labels, ok := args["remove_labels"].([]any); ok && len(labels) > 0 {
+		ids, err := resolveLabelIDs(impl.Client, owner, repo, labels)
+		if err != nil {
+			return nil, nil, resolveError(ActionEdit, "issue_bulk", err)
+		}
+		fn = removeLabelsFunc(impl.Client, owner, repo, ids)
+		changes++
+	}
+	if changes != 1 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue_bulk",
+			"exactly one change is required: state, milestone, assignees, add_labels or remove_labels"))
+	}
+
+	return issueBulk(ctx, impl.Client, ActionEdit, "issue_bulk", args, fn)
+}
@@ -10,6 +10,8 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
@@ -38,7 +40,7 @@ func (CreateImpl) Definition() *mcp.Tool {
 		Name:  "create_gitea",
 		Title: "Create Gitea Resource",
 		Description: `Create a resource in Forgejo/Gitea.
-Resources: issue, issue_comment, label, milestone, release, wiki_page, pull_request.
+Resources: issue, issue_move, issue_comment, issue_subscription, reaction, tracked_time, stopwatch, label, milestone, release, release_notes, wiki_page, pull_request, repo_watch, action_variable, action_secret.
 Use gitea_manual(action="create") for details.`,
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:    false,
@@ -51,18 +53,21 @@ Use gitea_manual(action="create") for details.`,
 				"resource": {
 					Type:        "string",
 					Description: "Resource type to create",
-					Enum:        []any{"issue", "issue_comment", "label", "milestone", "release", "wiki_page", "pull_request"},
+					Enum: []any{
+						"issue", "issue_move", "issue_comment", "issue_subscription", "reaction", "tracked_time", "stopwatch", "label", "milestone", "release", "release_notes", "wiki_page", "pull_request", "repo_watch",
+						"action_variable", "action_secret",
+					},
 				},
 				"owner": {
 					Type:        "string",
-					Description: "Repository owner",
+					Description: "Repository owner (not required for org/user scoped resources)",
 				},
 				"repo": {
 					Type:        "string",
-					Description: "Repository name",
+					Description: "Repository name (not required for org/user scoped resources)",
 				},
 			},
-			Required:             []string{"resource", "owner", "repo"},
+			Required:             []string{"resource"},
 			AdditionalProperties: &jsonschema.Schema{},
 		},
 	}
@@ -86,20 +91,38 @@ func (impl CreateImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 		switch resource {
 		case "issue":
 			return impl.createIssue(args)
+		case "issue_move":
+			return impl.createIssueMove(args)
 		case "issue_comment":
 			return impl.createIssueComment(args)
+		case "issue_subscription":
+			return impl.createIssueSubscription(args)
+		case "reaction":
+			return impl.createReaction(args)
+		case "tracked_time":
+			return impl.createTrackedTime(args)
+		case "stopwatch":
+			return impl.createStopwatch(args)
 		case "label":
 			return impl.createLabel(args)
 		case "milestone":
 			return impl.createMilestone(args)
 		case "release":
 			return impl.createRelease(args)
+		case "release_notes":
+			return impl.createReleaseNotes(args)
 		case "wiki_page":
 			return impl.createWikiPage(args)
 		case "pull_request":
 			return impl.createPullRequest(args)
+		case "repo_watch":
+			return impl.createRepoWatch(args)
+		case "action_variable":
+			return impl.createActionVariable(args)
+		case "action_secret":
+			return impl.createActionSecret(args)
 		default:
-			return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, resource, "not implemented"))
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, resource, "not implemented"))
 		}
 	}
 }
@@ -107,17 +130,18 @@ func (impl CreateImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 func (impl CreateImpl) createIssue(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "issue", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue", err.Error()))
 	}
 
 	title, _ := args["title"].(string)
 	if title == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "issue", "title is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue", "title is required"))
 	}
 
 	body, _ := args["body"].(string)
-	if body == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "issue", "body is required"))
+	templateName, _ := args["template"].(string)
+	if body == "" && templateName == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue", "body is required"))
 	}
 
 	opt := forgejo.CreateIssueOption{
@@ -126,15 +150,19 @@ func (impl CreateImpl) createIssue(args map[string]any) (*mcp.CallToolResult, an
 	}
 
 	if assignees, ok := args["assignees"].([]any); ok {
-		opt.Assignees = toStringSlice(assignees)
+		if opt.Assignees, err = resolveAssignees(impl.Client, owner, repo, assignees); err != nil {
+			return nil, nil, resolveError(ActionCreate, "issue", err)
+		}
 	}
 
-	if milestone, ok := args["milestone"].(float64); ok && milestone > 0 {
-		opt.Milestone = int64(milestone)
+	if opt.Milestone, _, err = resolveMilestoneID(impl.Client, owner, repo, args["milestone"]); err != nil {
+		return nil, nil, resolveError(ActionCreate, "issue", err)
 	}
 
 	if labels, ok := args["labels"].([]any); ok {
-		opt.Labels = toInt64Slice(labels)
+		if opt.Labels, err = resolveLabelIDs(impl.Client, owner, repo, labels); err != nil {
+			return nil, nil, resolveError(ActionCreate, "issue", err)
+		}
 	}
 
 	if dueDateStr, ok := args["due_date"].(string); ok && dueDateStr != "" {
@@ -145,28 +173,82 @@ func (impl CreateImpl) createIssue(args map[string]any) (*mcp.CallToolResult, an
 		opt.Deadline = &dueDate
 	}
 
+	var notes string
+	if templateName != "" {
+		fields, _ := args["fields"].(map[string]any)
+		notes, err = impl.applyIssueTemplate(owner, repo, templateName, fields, &opt)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	issue, _, err := impl.Client.CreateIssue(owner, repo, opt)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create issue: %w", err)
 	}
 
-	return textResult((&types.Issue{Issue: issue}).ToMarkdown()), nil, nil
+	return textResult(notes + (&types.Issue{Issue: issue}).ToMarkdown()), nil, nil
+}
+
+// applyIssueTemplate renders an issue template into opt: the title prefix,
+// the body from form fields, and the default labels, assignees and ref.
+// Template labels missing in the repository are returned as a note.
+func (impl CreateImpl) applyIssueTemplate(owner, repo, name string, fields map[string]any, opt *forgejo.CreateIssueOption) (string, error) {
+	templates, err := loadIssueTemplates(impl.Client, owner, repo, "")
+	if err != nil {
+		return "", err
+	}
+	tmpl, err := findIssueTemplate(templates, types.TemplateKindIssue, name)
+	if err != nil {
+		return "", fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue", err.Error()))
+	}
+
+	opt.Body, err = tmpl.RenderBody(opt.Body, fields)
+	if err != nil {
+		return "", fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue", err.Error()))
+	}
+	opt.Title = tmpl.RenderTitle(opt.Title)
+	if opt.Ref == "" {
+		opt.Ref = tmpl.Ref
+	}
+	for _, a := range tmpl.Assignees {
+		if !slices.Contains(opt.Assignees, a) {
+			opt.Assignees = append(opt.Assignees, a)
+		}
+	}
+
+	if len(tmpl.Labels) == 0 {
+		return "", nil
+	}
+	ids, missing, err := labelIDsByName(impl.Client, owner, repo, tmpl.Labels)
+	if err != nil {
+		return "", fmt.Errorf("failed to map template labels: %w", err)
+	}
+	for _, id := range ids {
+		if !slices.Contains(opt.Labels, id) {
+			opt.Labels = append(opt.Labels, id)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Sprintf("Template labels not found in the repository or its organization: %s\n\n", strings.Join(missing, ", ")), nil
+	}
+	return "", nil
 }
 
 func (impl CreateImpl) createIssueComment(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "issue_comment", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue_comment", err.Error()))
 	}
 
 	index, ok := args["index"].(float64)
 	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "issue_comment", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue_comment", "index is required"))
 	}
 
 	body, _ := args["body"].(string)
 	if body == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "issue_comment", "body is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue_comment", "body is required"))
 	}
 
 	opt := forgejo.CreateIssueCommentOption{Body: body}
@@ -179,46 +261,48 @@ func (impl CreateImpl) createIssueComment(args map[string]any) (*mcp.CallToolRes
 }
 
 func (impl CreateImpl) createLabel(args map[string]any) (*mcp.CallToolResult, any, error) {
-	owner, repo, err := extractOwnerRepo(args)
+	scope, _, err := extractLabelScope(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "label", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "label", err.Error()))
 	}
 
 	name, _ := args["name"].(string)
 	if name == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "label", "name is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "label", "name is required"))
 	}
 
 	color, _ := args["color"].(string)
 	if color == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "label", "color is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "label", "color is required"))
 	}
 
 	description, _ := args["description"].(string)
+	exclusive, _ := args["exclusive"].(bool)
 
-	opt := forgejo.CreateLabelOption{
+	opt := types.MyCreateLabelOption{
 		Name:        name,
 		Color:       color,
 		Description: description,
+		Exclusive:   exclusive,
 	}
 
-	label, _, err := impl.Client.CreateLabel(owner, repo, opt)
+	label, err := impl.Client.MyCreateLabel(scope, opt)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create label: %w", err)
 	}
 
-	return textResult((&types.Label{Label: label}).ToMarkdown()), nil, nil
+	return textResult(label.ToMarkdown()), nil, nil
 }
 
 func (impl CreateImpl) createMilestone(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "milestone", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "milestone", err.Error()))
 	}
 
 	title, _ := args["title"].(string)
 	if title == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "milestone", "title is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "milestone", "title is required"))
 	}
 
 	description, _ := args["description"].(string)
@@ -247,17 +331,17 @@ func (impl CreateImpl) createMilestone(args map[string]any) (*mcp.CallToolResult
 func (impl CreateImpl) createRelease(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "release", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "release", err.Error()))
 	}
 
 	tagName, _ := args["tag_name"].(string)
 	if tagName == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "release", "tag_name is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "release", "tag_name is required"))
 	}
 
 	name, _ := args["name"].(string)
 	if name == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "release", "name is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "release", "name is required"))
 	}
 
 	opt := forgejo.CreateReleaseOption{
@@ -289,17 +373,17 @@ func (impl CreateImpl) createRelease(args map[string]any) (*mcp.CallToolResult,
 func (impl CreateImpl) createWikiPage(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "wiki_page", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "wiki_page", err.Error()))
 	}
 
 	title, _ := args["title"].(string)
 	if title == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "wiki_page", "title is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "wiki_page", "title is required"))
 	}
 
 	content, _ := args["content"].(string)
 	if content == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "wiki_page", "content is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "wiki_page", "content is required"))
 	}
 
 	message, _ := args["message"].(string)
@@ -321,22 +405,22 @@ func (impl CreateImpl) createWikiPage(args map[string]any) (*mcp.CallToolResult,
 func (impl CreateImpl) createPullRequest(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "pull_request", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "pull_request", err.Error()))
 	}
 
 	title, _ := args["title"].(string)
 	if title == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "pull_request", "title is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "pull_request", "title is required"))
 	}
 
 	head, _ := args["head"].(string)
 	if head == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "pull_request", "head is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "pull_request", "head is required"))
 	}
 
 	base, _ := args["base"].(string)
 	if base == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionCreate, "pull_request", "base is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "pull_request", "base is required"))
 	}
 
 	opt := forgejo.CreatePullRequestOption{
@@ -349,13 +433,17 @@ func (impl CreateImpl) createPullRequest(args map[string]any) (*mcp.CallToolResu
 		opt.Body = body
 	}
 	if assignees, ok := args["assignees"].([]any); ok {
-		opt.Assignees = toStringSlice(assignees)
+		if opt.Assignees, err = resolveAssignees(impl.Client, owner, repo, assignees); err != nil {
+			return nil, nil, resolveError(ActionCreate, "pull_request", err)
+		}
 	}
-	if milestone, ok := args["milestone"].(float64); ok && milestone > 0 {
-		opt.Milestone = int64(milestone)
+	if opt.Milestone, _, err = resolveMilestoneID(impl.Client, owner, repo, args["milestone"]); err != nil {
+		return nil, nil, resolveError(ActionCreate, "pull_request", err)
 	}
 	if labels, ok := args["labels"].([]any); ok {
-		opt.Labels = toInt64Slice(labels)
+		if opt.Labels, err = resolveLabelIDs(impl.Client, owner, repo, labels); err != nil {
+			return nil, nil, resolveError(ActionCreate, "pull_request", err)
+		}
 	}
 
 	pr, _, err := impl.Client.CreatePullRequest(owner, repo, opt)
@@ -366,6 +454,112 @@ func (impl CreateImpl) createPullRequest(args map[string]any) (*mcp.CallToolResu
 	return textResult((&types.PullRequest{PullRequest: pr}).ToMarkdown()), nil, nil
 }
 
+func (impl CreateImpl) createActionVariable(args map[string]any) (*mcp.CallToolResult, any, error) {
+	scope, where, err := extractActionScope(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "action_variable", err.Error()))
+	}
+
+	name, _ := args["name"].(string)
+	if name == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "action_variable", "name is required"))
+	}
+
+	value, ok := args["value"].(string)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "action_variable", "value is required"))
+	}
+
+	err = impl.Client.MyCreateActionVariable(scope, name, types.MyActionVariableOption{Value: value})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create variable: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("Variable %s created in %s", name, where)), nil, nil
+}
+
+func (impl CreateImpl) createActionSecret(args map[string]any) (*mcp.CallToolResult, any, error) {
+	scope, where, err := extractActionScope(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "action_secret", err.Error()))
+	}
+
+	name, _ := args["name"].(string)
+	if name == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "action_secret", "name is required"))
+	}
+
+	value, _ := args["value"].(string)
+	if value == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "action_secret", "value is required"))
+	}
+
+	// the PUT replaces an existing secret, whose value is then lost for good
+	secrets, err := listAllActionSecrets(impl.Client, scope)
+	switch {
+	case err != nil:
+		// secrets of the user scope cannot be listed
+		if confirm, _ := args["confirm"].(bool); !confirm {
+			return nil, nil, fmt.Errorf("cannot check whether secret %s already exists in %s (%v); storing it would replace an existing one for good, call again with confirm=true to proceed", name, where, err)
+		}
+	case slices.ContainsFunc(secrets, func(s *types.MyActionSecret) bool { return strings.EqualFold(s.Name, name) }):
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "action_secret",
+			fmt.Sprintf("secret %s already exists in %s; use edit:action_secret with confirm=true to replace it", name, where)))
+	}
+
+	err = impl.Client.MyPutActionSecret(scope, name, value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create secret: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("Secret %s stored in %s", name, where)), nil, nil
+}
+
+// listAllActionSecrets loads the names of every secret of a scope.
+func listAllActionSecrets(client *tools.Client, scope tools.ActionScope) ([]*types.MyActionSecret, error) {
+	var ret []*types.MyActionSecret
+	for page := 1; ; page++ {
+		secrets, err := client.MyListActionSecrets(scope, page, listPageSize)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, secrets...)
+		if len(secrets) < listPageSize {
+			return ret, nil
+		}
+	}
+}
+
+func (impl CreateImpl) createReaction(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "reaction", err.Error()))
+	}
+
+	index, commentID, what, err := extractReactionTarget(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "reaction", err.Error()))
+	}
+
+	content, _ := args["content"].(string)
+	content = types.NormalizeReaction(content)
+	if content == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "reaction", "content is required"))
+	}
+
+	var reaction *forgejo.Reaction
+	if commentID > 0 {
+		reaction, _, err = impl.Client.PostIssueCommentReaction(owner, repo, commentID, content)
+	} else {
+		reaction, _, err = impl.Client.PostIssueReaction(owner, repo, index, content)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("Reacted to %s: %s", what, (&types.Reaction{Reaction: reaction}).ToMarkdown())), nil, nil
+}
+
 // Helper functions
 
 func extractOwnerRepo(args map[string]any) (string, string, error) {
@@ -380,6 +574,77 @@ func extractOwnerRepo(args map[string]any) (string, string, error) {
 	return owner, repo, nil
 }
 
+// extractActionScope resolves the owner of Actions settings from the 'scope'
+// argument: 'repo' (default) uses owner/repo, 'org' uses org, 'user' refers to
+// the authenticated user. The second return value describes the scope for
+// result messages.
+func extractActionScope(args map[string]any) (tools.ActionScope, string, error) {
+	scope, _ := args["scope"].(string)
+	switch scope {
+	case "", "repo":
+		owner, repo, err := extractOwnerRepo(args)
+		if err != nil {
+			return "", "", err
+		}
+		return tools.RepoActionScope(owner, repo), "repository " + owner + "/" + repo, nil
+	case "org":
+		org, _ := args["org"].(string)
+		if org == "" {
+			return "", "", fmt.Errorf("org is required for scope='org'")
+		}
+		return tools.OrgActionScope(org), "organization " + org, nil
+	case "user":
+		return tools.UserActionScope, "your account", nil
+	default:
+		return "", "", fmt.Errorf("scope must be 'repo', 'org', or 'user'")
+	}
+}
+
+// extractLabelScope resolves the owner of labels: the organization given as
+// org when no repo is given, otherwise the repository owner/repo. The second
+// return value describes the scope for result messages.
+func extractLabelScope(args map[string]any) (tools.LabelScope, string, error) {
+	if org, _ := args["org"].(string); org != "" {
+		if repo, _ := args["repo"].(string); repo == "" {
+			return tools.OrgLabelScope(org), "organization " + org, nil
+		}
+	}
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return "", "", fmt.Errorf("%w (or give org for organization labels)", err)
+	}
+	return tools.RepoLabelScope(owner, repo), "repository " + owner + "/" + repo, nil
+}
+
+// extractRunnerScope is extractActionScope with the additional 'instance'
+// scope, which covers runners shared by the whole site.
+func extractRunnerScope(args map[string]any) (tools.ActionScope, string, error) {
+	if scope, _ := args["scope"].(string); scope == "instance" {
+		return tools.InstanceActionScope, "the instance", nil
+	}
+	return extractActionScope(args)
+}
+
+// extractReactionTarget returns the issue index or, when comment_id is given,
+// the comment ID that reactions refer to, plus a description for messages.
+func extractReactionTarget(args map[string]any) (index, commentID int64, what string, err error) {
+	if id, ok := args["comment_id"].(float64); ok && id > 0 {
+		return 0, int64(id), fmt.Sprintf("comment %d", int64(id)), nil
+	}
+	if n, ok := args["index"].(float64); ok && n > 0 {
+		return int64(n), 0, fmt.Sprintf("issue #%d", int64(n)), nil
+	}
+	return 0, 0, "", fmt.Errorf("index or comment_id is required")
+}
+
+// requireConfirm guards destructive operations behind an explicit confirm=true.
+func requireConfirm(args map[string]any, what string) error {
+	if confirm, _ := args["confirm"].(bool); !confirm {
+		return fmt.Errorf("%s cannot be undone; call again with confirm=true to proceed", what)
+	}
+	return nil
+}
+
 func toStringSlice(arr []any) []string {
 	result := make([]string, 0, len(arr))
 	for _, v := range arr {
@@ -28,7 +28,7 @@ func (DeleteImpl) Definition() *mcp.Tool {
 		Name:  "delete_gitea",
 		Title: "Delete Gitea Resource",
 		Description: `Delete a resource from Forgejo/Gitea. This action cannot be undone.
-Resources: issue_comment, issue_attachment, label, milestone, release, release_attachment, wiki_page.
+Resources: issue_comment, issue_subscription, reaction, tracked_time, stopwatch, issue_attachment, label, milestone, release, release_attachment, wiki_page, repo_watch, action_variable, action_secret.
 Use gitea_manual(action="delete") for details.`,
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:    false,
@@ -42,20 +42,21 @@ Use gitea_manual(action="delete") for details.`,
 					Type:        "string",
 					Description: "Resource type to delete",
 					Enum: []any{
-						"issue_comment", "issue_attachment", "label",
-						"milestone", "release", "release_attachment", "wiki_page",
+						"issue_comment", "issue_subscription", "reaction", "tracked_time", "stopwatch", "issue_attachment", "label",
+						"milestone", "release", "release_attachment", "wiki_page", "repo_watch",
+						"action_variable", "action_secret",
 					},
 				},
 				"owner": {
 					Type:        "string",
-					Description: "Repository owner",
+					Description: "Repository owner (not required for org/user scoped resources)",
 				},
 				"repo": {
 					Type:        "string",
-					Description: "Repository name",
+					Description: "Repository name (not required for org/user scoped resources)",
 				},
 			},
-			Required:             []string{"resource", "owner", "repo"},
+			Required:             []string{"resource"},
 			AdditionalProperties: &jsonschema.Schema{},
 		},
 	}
@@ -78,6 +79,14 @@ func (impl DeleteImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 		switch resource {
 		case "issue_comment":
 			return impl.deleteIssueComment(args)
+		case "issue_subscription":
+			return impl.deleteIssueSubscription(args)
+		case "reaction":
+			return impl.deleteReaction(args)
+		case "tracked_time":
+			return impl.deleteTrackedTime(args)
+		case "stopwatch":
+			return impl.deleteStopwatch(args)
 		case "issue_attachment":
 			return impl.deleteIssueAttachment(args)
 		case "label":
@@ -90,8 +99,14 @@ func (impl DeleteImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 			return impl.deleteReleaseAttachment(args)
 		case "wiki_page":
 			return impl.deleteWikiPage(args)
+		case "repo_watch":
+			return impl.deleteRepoWatch(args)
+		case "action_variable":
+			return impl.deleteActionVariable(args)
+		case "action_secret":
+			return impl.deleteActionSecret(args)
 		default:
-			return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, resource, "not implemented"))
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, resource, "not implemented"))
 		}
 	}
 }
@@ -99,12 +114,12 @@ func (impl DeleteImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 func (impl DeleteImpl) deleteIssueComment(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "issue_comment", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "issue_comment", err.Error()))
 	}
 
 	id, ok := args["id"].(float64)
 	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "issue_comment", "id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "issue_comment", "id is required"))
 	}
 
 	_, err = impl.Client.DeleteIssueComment(owner, repo, int64(id))
@@ -118,17 +133,17 @@ func (impl DeleteImpl) deleteIssueComment(args map[string]any) (*mcp.CallToolRes
 func (impl DeleteImpl) deleteIssueAttachment(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "issue_attachment", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "issue_attachment", err.Error()))
 	}
 
 	index, ok := args["index"].(float64)
 	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "issue_attachment", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "issue_attachment", "index is required"))
 	}
 
 	attachmentID, ok := args["attachment_id"].(float64)
 	if !ok || attachmentID <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "issue_attachment", "attachment_id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "issue_attachment", "attachment_id is required"))
 	}
 
 	err = impl.Client.MyDeleteIssueAttachment(owner, repo, int64(index), int64(attachmentID))
@@ -140,17 +155,20 @@ func (impl DeleteImpl) deleteIssueAttachment(args map[string]any) (*mcp.CallTool
 }
 
 func (impl DeleteImpl) deleteLabel(args map[string]any) (*mcp.CallToolResult, any, error) {
-	owner, repo, err := extractOwnerRepo(args)
+	scope, where, err := extractLabelScope(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "label", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "label", err.Error()))
 	}
 
-	id, ok := args["id"].(float64)
-	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "label", "id is required"))
+	id, ok, err := resolveScopeLabelID(impl.Client, scope, where, args["id"])
+	if err != nil {
+		return nil, nil, resolveError(ActionDelete, "label", err)
+	}
+	if !ok {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "label", "id is required"))
 	}
 
-	_, err = impl.Client.DeleteLabel(owner, repo, int64(id))
+	err = impl.Client.MyDeleteLabel(scope, id)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to delete label: %w", err)
 	}
@@ -161,15 +179,18 @@ func (impl DeleteImpl) deleteLabel(args map[string]any) (*mcp.CallToolResult, an
 func (impl DeleteImpl) deleteMilestone(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "milestone", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "milestone", err.Error()))
 	}
 
-	id, ok := args["id"].(float64)
+	id, ok, err := resolveMilestoneID(impl.Client, owner, repo, args["id"])
+	if err != nil {
+		return nil, nil, resolveError(ActionDelete, "milestone", err)
+	}
 	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "milestone", "id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "milestone", "id is required"))
 	}
 
-	_, err = impl.Client.DeleteMilestone(owner, repo, int64(id))
+	_, err = impl.Client.DeleteMilestone(owner, repo, id)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to delete milestone: %w", err)
 	}
@@ -180,12 +201,12 @@ func (impl DeleteImpl) deleteMilestone(args map[string]any) (*mcp.CallToolResult
 func (impl DeleteImpl) deleteRelease(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "release", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "release", err.Error()))
 	}
 
 	id, ok := args["id"].(float64)
 	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "release", "id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "release", "id is required"))
 	}
 
 	_, err = impl.Client.DeleteRelease(owner, repo, int64(id))
@@ -199,17 +220,17 @@ func (impl DeleteImpl) deleteRelease(args map[string]any) (*mcp.CallToolResult,
 func (impl DeleteImpl) deleteReleaseAttachment(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "release_attachment", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "release_attachment", err.Error()))
 	}
 
 	id, ok := args["id"].(float64)
 	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "release_attachment", "id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "release_attachment", "id is required"))
 	}
 
 	attachmentID, ok := args["attachment_id"].(float64)
 	if !ok || attachmentID <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "release_attachment", "attachment_id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "release_attachment", "attachment_id is required"))
 	}
 
 	_, err = impl.Client.DeleteReleaseAttachment(owner, repo, int64(id), int64(attachmentID))
@@ -223,12 +244,12 @@ func (impl DeleteImpl) deleteReleaseAttachment(args map[string]any) (*mcp.CallTo
 func (impl DeleteImpl) deleteWikiPage(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "wiki_page", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "wiki_page", err.Error()))
 	}
 
 	pageName, _ := args["page_name"].(string)
 	if pageName == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionDelete, "wiki_page", "page_name is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "wiki_page", "page_name is required"))
 	}
 
 	err = impl.Client.MyDeleteWikiPage(owner, repo, pageName)
@@ -238,3 +259,78 @@ func (impl DeleteImpl) deleteWikiPage(args map[string]any) (*mcp.CallToolResult,
 
 	return textResult(types.EmptyResponse{}.ToMarkdown()), nil, nil
 }
+
+func (impl DeleteImpl) deleteActionVariable(args map[string]any) (*mcp.CallToolResult, any, error) {
+	scope, where, err := extractActionScope(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "action_variable", err.Error()))
+	}
+
+	name, _ := args["name"].(string)
+	if name == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "action_variable", "name is required"))
+	}
+
+	if err := requireConfirm(args, "deleting variable "+name+" from "+where); err != nil {
+		return nil, nil, err
+	}
+
+	err = impl.Client.MyDeleteActionVariable(scope, name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to delete variable: %w", err)
+	}
+
+	return textResult(types.EmptyResponse{}.ToMarkdown()), nil, nil
+}
+
+func (impl DeleteImpl) deleteActionSecret(args map[string]any) (*mcp.CallToolResult, any, error) {
+	scope, where, err := extractActionScope(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "action_secret", err.Error()))
+	}
+
+	name, _ := args["name"].(string)
+	if name == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "action_secret", "name is required"))
+	}
+
+	if err := requireConfirm(args, "deleting secret "+name+" from "+where); err != nil {
+		return nil, nil, err
+	}
+
+	err = impl.Client.MyDeleteActionSecret(scope, name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to delete secret: %w", err)
+	}
+
+	return textResult(types.EmptyResponse{}.ToMarkdown()), nil, nil
+}
+
+func (impl DeleteImpl) deleteReaction(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "reaction", err.Error()))
+	}
+
+	index, commentID, what, err := extractReactionTarget(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "reaction", err.Error()))
+	}
+
+	content, _ := args["content"].(string)
+	content = types.NormalizeReaction(content)
+	if content == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "reaction", "content is required"))
+	}
+
+	if commentID > 0 {
+		_, err = impl.Client.DeleteIssueCommentReaction(owner, repo, commentID, content)
+	} else {
+		_, err = impl.Client.DeleteIssueReaction(owner, repo, index, content)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to remove reaction: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("Reaction :%s: removed from %s.", content, what)), nil, nil
+}
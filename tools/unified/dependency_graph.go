@@ -0,0 +1,161 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+const (
+	defaultGraphIssues = 100
+	maxGraphIssues     = 300
+)
+
+// graphDirections are the ways a dependency graph can be walked from its
+// starting issues.
+var graphDirections = []string{"dependencies", "blocking", "both"}
+
+// getIssueGraph walks issue dependencies transitively, across repositories,
+// from a root issue or from every issue of a milestone, and renders the
+// graph with its cycles and a topological work order.
+func (impl GetImpl) getIssueGraph(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "issue_graph", err.Error()))
+	}
+	index, hasIndex := args["index"].(float64)
+	milestone := milestoneArg(args)
+	if hasIndex == (milestone != "") {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "issue_graph", "exactly one of index or milestone is required"))
+	}
+	direction, _ := args["direction"].(string)
+	direction = cmp.Or(direction, "both")
+	if !slices.Contains(graphDirections, direction) {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "issue_graph", "direction must be 'dependencies', 'blocking' or 'both'"))
+	}
+	limit := defaultGraphIssues
+	if n, ok := args["max_issues"].(float64); ok && n > 0 {
+		limit = min(int(n), maxGraphIssues)
+	}
+
+	graph := types.NewDependencyGraph()
+	fullName := owner + "/" + repo
+	var queue []*types.DependencyNode
+	if hasIndex {
+		issue, _, err := impl.Client.GetIssue(owner, repo, int64(index))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get issue: %w", err)
+		}
+		queue = append(queue, graph.AddIssue(fullName, issue))
+	} else {
+		opt := forgejo.ListIssueOption{
+			ListOptions: forgejo.ListOptions{PageSize: listPageSize},
+			State:       forgejo.StateAll,
+			Type:        forgejo.IssueTypeIssue,
+			Milestones:  []string{milestone},
+		}
+		for opt.Page = 1; ; opt.Page++ {
+			issues, _, err := impl.Client.ListRepoIssues(owner, repo, opt)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list milestone issues: %w", err)
+			}
+			for _, issue := range issues {
+				if len(graph.Keys) >= limit {
+					graph.Truncated = true
+					break
+				}
+				queue = append(queue, graph.AddIssue(fullName, issue))
+			}
+			if len(issues) < listPageSize || graph.Truncated {
+				break
+			}
+		}
+		if len(queue) == 0 {
+			return textResult(fmt.Sprintf("No issues found in milestone '%s'.", milestone)), nil, nil
+		}
+	}
+
+	// Closed issues are leaves: what they depend on no longer matters for
+	// planning, so their links are not followed except from the roots.
+	roots := len(queue)
+	for i := 0; i < len(queue); i++ {
+		node := queue[i]
+		if i >= roots && !node.Open() {
+			continue
+		}
+		nodeOwner, nodeRepo, _ := strings.Cut(node.Repo, "/")
+		if direction != "blocking" {
+			deps, err := impl.Client.MyListIssueDependencies(nodeOwner, nodeRepo, node.Issue.Index)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list dependencies of %s: %w", node.Key, err)
+			}
+			for _, dep := range deps {
+				linked, added := graphLink(graph, node.Repo, dep, limit)
+				if linked == nil {
+					continue
+				}
+				graph.AddDependency(node.Key, linked.Key)
+				if added {
+					queue = append(queue, linked)
+				}
+			}
+		}
+		if direction != "dependencies" {
+			blocked, err := impl.Client.MyListIssueBlocking(nodeOwner, nodeRepo, node.Issue.Index)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list issues blocked by %s: %w", node.Key, err)
+			}
+			for _, b := range blocked {
+				linked, added := graphLink(graph, node.Repo, b, limit)
+				if linked == nil {
+					continue
+				}
+				graph.AddDependency(linked.Key, node.Key)
+				if added {
+					queue = append(queue, linked)
+				}
+			}
+		}
+	}
+
+	return textResult(graph.ToMarkdown()), nil, nil
+}
+
+// graphLink returns the node of a linked issue, adding it to the graph if
+// there is room left. added reports whether the node is new; a nil node
+// means the graph is full.
+func graphLink(graph *types.DependencyGraph, fallbackRepo string, issue *forgejo.Issue, limit int) (node *types.DependencyNode, added bool) {
+	repo := types.IssueRepo(issue, fallbackRepo)
+	if n, ok := graph.Nodes[types.IssueKey(repo, issue.Index)]; ok {
+		return n, false
+	}
+	if len(graph.Keys) >= limit {
+		graph.Truncated = true
+		return nil, false
+	}
+	return graph.AddIssue(repo, issue), true
+}
+
+// milestoneArg accepts the milestone as a title or a numeric ID.
+func milestoneArg(args map[string]any) string {
+	switch v := args["milestone"].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatInt(int64(v), 10)
+	}
+	return ""
+}
@@ -31,7 +31,7 @@ func (EditImpl) Definition() *mcp.Tool {
 		Name:  "edit_gitea",
 		Title: "Edit Gitea Resource",
 		Description: `Edit an existing resource in Forgejo/Gitea.
-Resources: issue, issue_comment, issue_attachment, label, milestone, release, release_attachment, wiki_page.
+Resources: issue, issue_bulk, issue_comment, stopwatch, issue_attachment, label, label_sync, milestone, milestone_sync, release, release_attachment, wiki_page, action_variable, action_secret.
 Use gitea_manual(action="edit") for details.`,
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:    false,
@@ -45,20 +45,21 @@ Use gitea_manual(action="edit") for details.`,
 					Type:        "string",
 					Description: "Resource type to edit",
 					Enum: []any{
-						"issue", "issue_comment", "issue_attachment", "label",
-						"milestone", "release", "release_attachment", "wiki_page",
+						"issue", "issue_bulk", "issue_comment", "stopwatch", "issue_attachment", "label", "label_sync",
+						"milestone", "milestone_sync", "release", "release_attachment", "wiki_page",
+						"action_variable", "action_secret",
 					},
 				},
 				"owner": {
 					Type:        "string",
-					Description: "Repository owner",
+					Description: "Repository owner (not required for org/user scoped resources)",
 				},
 				"repo": {
 					Type:        "string",
-					Description: "Repository name",
+					Description: "Repository name (not required for org/user scoped resources)",
 				},
 			},
-			Required:             []string{"resource", "owner", "repo"},
+			Required:             []string{"resource"},
 			AdditionalProperties: &jsonschema.Schema{},
 		},
 	}
@@ -81,22 +82,34 @@ func (impl EditImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 		switch resource {
 		case "issue":
 			return impl.editIssue(args)
+		case "issue_bulk":
+			return impl.editIssueBulk(ctx, args)
 		case "issue_comment":
 			return impl.editIssueComment(args)
+		case "stopwatch":
+			return impl.editStopwatch(args)
 		case "issue_attachment":
 			return impl.editIssueAttachment(args)
 		case "label":
 			return impl.editLabel(args)
+		case "label_sync":
+			return impl.editLabelSync(args)
 		case "milestone":
 			return impl.editMilestone(args)
+		case "milestone_sync":
+			return impl.editMilestoneSync(args)
 		case "release":
 			return impl.editRelease(args)
 		case "release_attachment":
 			return impl.editReleaseAttachment(args)
 		case "wiki_page":
 			return impl.editWikiPage(args)
+		case "action_variable":
+			return impl.editActionVariable(args)
+		case "action_secret":
+			return impl.editActionSecret(args)
 		default:
-			return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, resource, "not implemented"))
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, resource, "not implemented"))
 		}
 	}
 }
@@ -104,32 +117,43 @@ func (impl EditImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 func (impl EditImpl) editIssue(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "issue", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue", err.Error()))
 	}
 
 	index, ok := args["index"].(float64)
 	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "issue", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue", "index is required"))
 	}
 
 	opt := forgejo.EditIssueOption{}
+	edited := false
 
 	if title, ok := args["title"].(string); ok && title != "" {
 		opt.Title = title
+		edited = true
 	}
 	if body, ok := args["body"].(string); ok && body != "" {
 		opt.Body = &body
+		edited = true
 	}
 	if state, ok := args["state"].(string); ok && state != "" {
 		s := forgejo.StateType(state)
 		opt.State = &s
+		edited = true
 	}
 	if assignees, ok := args["assignees"].([]any); ok {
-		opt.Assignees = toStringSlice(assignees)
+		if opt.Assignees, err = resolveAssignees(impl.Client, owner, repo, assignees); err != nil {
+			return nil, nil, resolveError(ActionEdit, "issue", err)
+		}
+		edited = true
+	}
+	milestone, ok, err := resolveMilestoneID(impl.Client, owner, repo, args["milestone"])
+	if err != nil {
+		return nil, nil, resolveError(ActionEdit, "issue", err)
 	}
-	if milestone, ok := args["milestone"].(float64); ok && milestone > 0 {
-		m := int64(milestone)
-		opt.Milestone = &m
+	if ok && milestone > 0 {
+		opt.Milestone = &milestone
+		edited = true
 	}
 	if dueDateStr, ok := args["due_date"].(string); ok && dueDateStr != "" {
 		dueDate, err := time.Parse(time.RFC3339, dueDateStr)
@@ -137,30 +161,56 @@ func (impl EditImpl) editIssue(args map[string]any) (*mcp.CallToolResult, any, e
 			return nil, nil, fmt.Errorf("invalid due_date format (expected RFC3339): %w", err)
 		}
 		opt.Deadline = &dueDate
+		edited = true
+	}
+
+	pinned, pin := args["pinned"].(bool)
+
+	var issue *forgejo.Issue
+	if edited || !pin {
+		issue, _, err = impl.Client.EditIssue(owner, repo, int64(index), opt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to edit issue: %w", err)
+		}
 	}
 
-	issue, _, err := impl.Client.EditIssue(owner, repo, int64(index), opt)
+	switch {
+	case pin && pinned:
+		err = impl.Client.MyPinIssue(owner, repo, int64(index))
+	case pin:
+		err = impl.Client.MyUnpinIssue(owner, repo, int64(index))
+	}
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to edit issue: %w", err)
+		return nil, nil, fmt.Errorf("failed to change pin state: %w", err)
+	}
+
+	if !pin {
+		return textResult((&types.Issue{Issue: issue}).ToMarkdown()), nil, nil
 	}
 
-	return textResult((&types.Issue{Issue: issue}).ToMarkdown()), nil, nil
+	// reload to show the resulting pinned state
+	current, err := impl.Client.MyGetIssue(owner, repo, int64(index))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	return textResult((&types.Issue{Issue: &current.Issue, PinOrder: current.PinOrder}).ToMarkdown()), nil, nil
 }
 
 func (impl EditImpl) editIssueComment(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "issue_comment", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue_comment", err.Error()))
 	}
 
 	id, ok := args["id"].(float64)
 	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "issue_comment", "id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue_comment", "id is required"))
 	}
 
 	body, _ := args["body"].(string)
 	if body == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "issue_comment", "body is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue_comment", "body is required"))
 	}
 
 	opt := forgejo.EditIssueCommentOption{Body: body}
@@ -175,22 +225,22 @@ func (impl EditImpl) editIssueComment(args map[string]any) (*mcp.CallToolResult,
 func (impl EditImpl) editIssueAttachment(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "issue_attachment", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue_attachment", err.Error()))
 	}
 
 	index, ok := args["index"].(float64)
 	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "issue_attachment", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue_attachment", "index is required"))
 	}
 
 	attachmentID, ok := args["attachment_id"].(float64)
 	if !ok || attachmentID <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "issue_attachment", "attachment_id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue_attachment", "attachment_id is required"))
 	}
 
 	name, _ := args["name"].(string)
 	if name == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "issue_attachment", "name is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "issue_attachment", "name is required"))
 	}
 
 	options := tools.MyEditAttachmentOptions{Name: name}
@@ -203,17 +253,20 @@ func (impl EditImpl) editIssueAttachment(args map[string]any) (*mcp.CallToolResu
 }
 
 func (impl EditImpl) editLabel(args map[string]any) (*mcp.CallToolResult, any, error) {
-	owner, repo, err := extractOwnerRepo(args)
+	scope, where, err := extractLabelScope(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "label", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "label", err.Error()))
 	}
 
-	id, ok := args["id"].(float64)
-	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "label", "id is required"))
+	id, ok, err := resolveScopeLabelID(impl.Client, scope, where, args["id"])
+	if err != nil {
+		return nil, nil, resolveError(ActionEdit, "label", err)
+	}
+	if !ok {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "label", "id is required"))
 	}
 
-	opt := forgejo.EditLabelOption{}
+	opt := types.MyEditLabelOption{}
 	if name, ok := args["name"].(string); ok && name != "" {
 		opt.Name = &name
 	}
@@ -223,24 +276,30 @@ func (impl EditImpl) editLabel(args map[string]any) (*mcp.CallToolResult, any, e
 	if description, ok := args["description"].(string); ok && description != "" {
 		opt.Description = &description
 	}
+	if exclusive, ok := args["exclusive"].(bool); ok {
+		opt.Exclusive = &exclusive
+	}
 
-	label, _, err := impl.Client.EditLabel(owner, repo, int64(id), opt)
+	label, err := impl.Client.MyEditLabel(scope, id, opt)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to edit label: %w", err)
 	}
 
-	return textResult((&types.Label{Label: label}).ToMarkdown()), nil, nil
+	return textResult(label.ToMarkdown()), nil, nil
 }
 
 func (impl EditImpl) editMilestone(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "milestone", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "milestone", err.Error()))
 	}
 
-	id, ok := args["id"].(float64)
+	id, ok, err := resolveMilestoneID(impl.Client, owner, repo, args["id"])
+	if err != nil {
+		return nil, nil, resolveError(ActionEdit, "milestone", err)
+	}
 	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "milestone", "id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "milestone", "id is required"))
 	}
 
 	opt := forgejo.EditMilestoneOption{}
@@ -262,7 +321,7 @@ func (impl EditImpl) editMilestone(args map[string]any) (*mcp.CallToolResult, an
 		opt.Deadline = &dueDate
 	}
 
-	milestone, _, err := impl.Client.EditMilestone(owner, repo, int64(id), opt)
+	milestone, _, err := impl.Client.EditMilestone(owner, repo, id, opt)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to edit milestone: %w", err)
 	}
@@ -273,12 +332,12 @@ func (impl EditImpl) editMilestone(args map[string]any) (*mcp.CallToolResult, an
 func (impl EditImpl) editRelease(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "release", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "release", err.Error()))
 	}
 
 	id, ok := args["id"].(float64)
 	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "release", "id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "release", "id is required"))
 	}
 
 	opt := forgejo.EditReleaseOption{}
@@ -312,22 +371,22 @@ func (impl EditImpl) editRelease(args map[string]any) (*mcp.CallToolResult, any,
 func (impl EditImpl) editReleaseAttachment(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "release_attachment", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "release_attachment", err.Error()))
 	}
 
 	id, ok := args["id"].(float64)
 	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "release_attachment", "id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "release_attachment", "id is required"))
 	}
 
 	attachmentID, ok := args["attachment_id"].(float64)
 	if !ok || attachmentID <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "release_attachment", "attachment_id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "release_attachment", "attachment_id is required"))
 	}
 
 	name, _ := args["name"].(string)
 	if name == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "release_attachment", "name is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "release_attachment", "name is required"))
 	}
 
 	opt := forgejo.EditAttachmentOptions{Name: name}
@@ -342,17 +401,17 @@ func (impl EditImpl) editReleaseAttachment(args map[string]any) (*mcp.CallToolRe
 func (impl EditImpl) editWikiPage(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "wiki_page", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "wiki_page", err.Error()))
 	}
 
 	pageName, _ := args["page_name"].(string)
 	if pageName == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "wiki_page", "page_name is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "wiki_page", "page_name is required"))
 	}
 
 	content, _ := args["content"].(string)
 	if content == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionEdit, "wiki_page", "content is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "wiki_page", "content is required"))
 	}
 
 	title, _ := args["title"].(string)
@@ -374,3 +433,67 @@ func (impl EditImpl) editWikiPage(args map[string]any) (*mcp.CallToolResult, any
 
 	return textResult((&types.WikiPage{MyWikiPage: page}).ToMarkdown()), nil, nil
 }
+
+func (impl EditImpl) editActionVariable(args map[string]any) (*mcp.CallToolResult, any, error) {
+	scope, where, err := extractActionScope(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "action_variable", err.Error()))
+	}
+
+	name, _ := args["name"].(string)
+	if name == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "action_variable", "name is required"))
+	}
+
+	value, ok := args["value"].(string)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "action_variable", "value is required"))
+	}
+
+	opt := types.MyActionVariableOption{Value: value}
+	if newName, ok := args["new_name"].(string); ok && newName != "" {
+		opt.Name = newName
+	}
+
+	if err := requireConfirm(args, "overwriting variable "+name); err != nil {
+		return nil, nil, err
+	}
+
+	err = impl.Client.MyUpdateActionVariable(scope, name, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to edit variable: %w", err)
+	}
+
+	if opt.Name != "" && opt.Name != name {
+		return textResult(fmt.Sprintf("Variable %s renamed to %s and updated in %s", name, opt.Name, where)), nil, nil
+	}
+	return textResult(fmt.Sprintf("Variable %s updated in %s", name, where)), nil, nil
+}
+
+func (impl EditImpl) editActionSecret(args map[string]any) (*mcp.CallToolResult, any, error) {
+	scope, where, err := extractActionScope(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "action_secret", err.Error()))
+	}
+
+	name, _ := args["name"].(string)
+	if name == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "action_secret", "name is required"))
+	}
+
+	value, _ := args["value"].(string)
+	if value == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "action_secret", "value is required"))
+	}
+
+	if err := requireConfirm(args, "overwriting secret "+name); err != nil {
+		return nil, nil, err
+	}
+
+	err = impl.Client.MyPutActionSecret(scope, name, value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to edit secret: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("Secret %s replaced in %s", name, where)), nil, nil
+}
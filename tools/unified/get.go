@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
 	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
@@ -28,7 +29,7 @@ func (GetImpl) Definition() *mcp.Tool {
 		Name:  "get_gitea",
 		Title: "Get Gitea Resource",
 		Description: `Get details of a single resource from Forgejo/Gitea.
-Resources: issue, wiki_page, pull_request, repository.
+Resources: issue, issue_template, wiki_page, pull_request, release, repository, action_run, action_report, time_report, deadline_report, milestone_report, issue_graph, action_workflow, action_artifact, action_variable, runner_token.
 Use gitea_manual(action="get") for details.`,
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:   true,
@@ -40,18 +41,21 @@ Use gitea_manual(action="get") for details.`,
 				"resource": {
 					Type:        "string",
 					Description: "Resource type to get",
-					Enum:        []any{"issue", "wiki_page", "pull_request", "repository"},
+					Enum: []any{
+						"issue", "issue_template", "wiki_page", "pull_request", "release", "repository",
+						"action_run", "action_report", "time_report", "deadline_report", "milestone_report", "issue_graph", "action_workflow", "action_artifact", "action_variable", "runner_token",
+					},
 				},
 				"owner": {
 					Type:        "string",
-					Description: "Repository owner",
+					Description: "Repository owner (not required for org/user scoped resources)",
 				},
 				"repo": {
 					Type:        "string",
-					Description: "Repository name",
+					Description: "Repository name (not required for org/user scoped resources)",
 				},
 			},
-			Required:             []string{"resource", "owner", "repo"},
+			Required:             []string{"resource"},
 			AdditionalProperties: &jsonschema.Schema{},
 		},
 	}
@@ -74,14 +78,38 @@ func (impl GetImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 		switch resource {
 		case "issue":
 			return impl.getIssue(args)
+		case "issue_template":
+			return impl.getIssueTemplate(args)
 		case "wiki_page":
 			return impl.getWikiPage(args)
 		case "pull_request":
 			return impl.getPullRequest(args)
+		case "release":
+			return impl.getRelease(args)
 		case "repository":
 			return impl.getRepository(args)
+		case "action_run":
+			return impl.getActionRun(ctx, req, args)
+		case "action_report":
+			return impl.getActionReport(ctx, args)
+		case "time_report":
+			return impl.getTimeReport(args)
+		case "deadline_report":
+			return impl.getDeadlineReport(args)
+		case "milestone_report":
+			return impl.getMilestoneReport(args)
+		case "issue_graph":
+			return impl.getIssueGraph(args)
+		case "action_workflow":
+			return impl.getActionWorkflow(args)
+		case "action_artifact":
+			return impl.getActionArtifact(args)
+		case "action_variable":
+			return impl.getActionVariable(args)
+		case "runner_token":
+			return impl.getRunnerToken(args)
 		default:
-			return nil, nil, fmt.Errorf(FormatValidationError(ActionGet, resource, "not implemented"))
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, resource, "not implemented"))
 		}
 	}
 }
@@ -89,31 +117,31 @@ func (impl GetImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 func (impl GetImpl) getIssue(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionGet, "issue", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "issue", err.Error()))
 	}
 
 	index, ok := args["index"].(float64)
 	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionGet, "issue", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "issue", "index is required"))
 	}
 
-	issue, _, err := impl.Client.GetIssue(owner, repo, int64(index))
+	issue, err := impl.Client.MyGetIssue(owner, repo, int64(index))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get issue: %w", err)
 	}
 
-	return textResult((&types.Issue{Issue: issue}).ToMarkdown()), nil, nil
+	return textResult((&types.Issue{Issue: &issue.Issue, PinOrder: issue.PinOrder}).ToMarkdown()), nil, nil
 }
 
 func (impl GetImpl) getWikiPage(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionGet, "wiki_page", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "wiki_page", err.Error()))
 	}
 
 	pageName, _ := args["page_name"].(string)
 	if pageName == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionGet, "wiki_page", "page_name is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "wiki_page", "page_name is required"))
 	}
 
 	page, err := impl.Client.MyGetWikiPage(owner, repo, pageName)
@@ -127,12 +155,12 @@ func (impl GetImpl) getWikiPage(args map[string]any) (*mcp.CallToolResult, any,
 func (impl GetImpl) getPullRequest(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionGet, "pull_request", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "pull_request", err.Error()))
 	}
 
 	index, ok := args["index"].(float64)
 	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionGet, "pull_request", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "pull_request", "index is required"))
 	}
 
 	pr, _, err := impl.Client.GetPullRequest(owner, repo, int64(index))
@@ -143,10 +171,47 @@ func (impl GetImpl) getPullRequest(args map[string]any) (*mcp.CallToolResult, an
 	return textResult((&types.PullRequest{PullRequest: pr}).ToMarkdown()), nil, nil
 }
 
+// getRelease gets a release by ID, by tag or the latest one, with its
+// assets.
+func (impl GetImpl) getRelease(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "release", err.Error()))
+	}
+
+	id, hasID := args["id"].(float64)
+	tag, _ := args["tag"].(string)
+	latest, _ := args["latest"].(bool)
+	given := 0
+	for _, ok := range []bool{hasID && id > 0, tag != "", latest} {
+		if ok {
+			given++
+		}
+	}
+	if given != 1 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "release", "exactly one of id, tag or latest is required"))
+	}
+
+	var release *forgejo.Release
+	switch {
+	case latest:
+		release, _, err = impl.Client.GetLatestRelease(owner, repo)
+	case tag != "":
+		release, _, err = impl.Client.GetReleaseByTag(owner, repo, tag)
+	default:
+		release, _, err = impl.Client.GetRelease(owner, repo, int64(id))
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get release: %w", err)
+	}
+
+	return textResult((&types.ReleaseDetail{Release: release}).ToMarkdown()), nil, nil
+}
+
 func (impl GetImpl) getRepository(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionGet, "repository", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "repository", err.Error()))
 	}
 
 	repository, _, err := impl.Client.GetRepo(owner, repo)
@@ -156,3 +221,93 @@ func (impl GetImpl) getRepository(args map[string]any) (*mcp.CallToolResult, any
 
 	return textResult((&types.Repository{Repository: repository}).ToMarkdown()), nil, nil
 }
+
+func (impl GetImpl) getActionArtifact(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "action_artifact", err.Error()))
+	}
+
+	id, ok := args["id"].(float64)
+	if !ok || id <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "action_artifact", "id is required"))
+	}
+
+	maxBytes := 65536
+	if v, ok := args["max_bytes"].(float64); ok && v > 0 {
+		maxBytes = int(v)
+	}
+
+	artifact, err := impl.Client.MyGetActionArtifact(owner, repo, int64(id))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get artifact: %w", err)
+	}
+	if artifact.Expired {
+		return textResult(artifact.ToMarkdown() + "\n\nThis artifact has expired and can no longer be downloaded."), nil, nil
+	}
+
+	data, err := impl.Client.MyDownloadActionArtifact(owner, repo, int64(id))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to download artifact: %w", err)
+	}
+
+	entries, err := types.ParseArtifactArchive(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if patterns, ok := args["entries"].([]any); ok && len(patterns) > 0 {
+		entries = entries.Match(toStringSlice(patterns))
+		if len(entries) == 0 {
+			return nil, nil, fmt.Errorf("no file in artifact '%s' matches %v", artifact.Name, patterns)
+		}
+		if err := entries.ReadText(maxBytes); err != nil {
+			return nil, nil, fmt.Errorf("failed to read artifact: %w", err)
+		}
+	}
+
+	result := textResult(artifact.ToMarkdown() + "\n\n" + entries.ToMarkdown())
+	if includeZip, _ := args["include_zip"].(bool); includeZip {
+		result.Content = append(result.Content, &mcp.EmbeddedResource{
+			Resource: &mcp.ResourceContents{
+				URI:      artifact.ArchiveDownloadURL,
+				MIMEType: "application/zip",
+				Blob:     data,
+			},
+		})
+	}
+	return result, nil, nil
+}
+
+func (impl GetImpl) getActionVariable(args map[string]any) (*mcp.CallToolResult, any, error) {
+	scope, _, err := extractActionScope(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "action_variable", err.Error()))
+	}
+
+	name, _ := args["name"].(string)
+	if name == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "action_variable", "name is required"))
+	}
+
+	variable, err := impl.Client.MyGetActionVariable(scope, name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get variable: %w", err)
+	}
+
+	return textResult((&types.ActionVariable{MyActionVariable: variable}).ToMarkdown()), nil, nil
+}
+
+func (impl GetImpl) getRunnerToken(args map[string]any) (*mcp.CallToolResult, any, error) {
+	scope, where, err := extractRunnerScope(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "runner_token", err.Error()))
+	}
+
+	token, err := impl.Client.MyGetRunnerRegistrationToken(scope)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get runner registration token: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("Runner registration token for %s: `%s`", where, token.Token)), nil, nil
+}
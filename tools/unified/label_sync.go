@@ -0,0 +1,122 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/tools"
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// listAllLabels loads every label of a repository or organization.
+func listAllLabels(client *tools.Client, scope tools.LabelScope) ([]*types.MyLabel, error) {
+	var ret []*types.MyLabel
+	for page := 1; ; page++ {
+		labels, err := client.MyListLabels(scope, page, listPageSize)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, labels...)
+		if len(labels) < listPageSize {
+			return ret, nil
+		}
+	}
+}
+
+// editLabelSync applies a canonical label set, taken from a YAML spec, a
+// repository or the labels of an organization, to many repositories. Labels
+// are matched by name or alias; with prune, labels outside the set are
+// deleted. Without confirm it only reports what would change.
+func (impl EditImpl) editLabelSync(args map[string]any) (*mcp.CallToolResult, any, error) {
+	source := ""
+	var specs []types.LabelSpec
+	if spec, _ := args["spec"].(string); spec != "" {
+		var err error
+		if specs, err = types.ParseLabelSpecs([]byte(spec)); err != nil {
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "label_sync", err.Error()))
+		}
+	} else {
+		var scope tools.LabelScope
+		if org, _ := args["source_org"].(string); org != "" {
+			scope = tools.OrgLabelScope(org)
+		} else {
+			owner, repo, err := extractOwnerRepo(args)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "label_sync", "one of spec, source_org or the source owner and repo is required"))
+			}
+			scope = tools.RepoLabelScope(owner, repo)
+			source = owner + "/" + repo
+		}
+		labels, err := listAllLabels(impl.Client, scope)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list source labels: %w", err)
+		}
+		for _, l := range labels {
+			if !l.IsArchived {
+				specs = append(specs, types.LabelSpecOf(l))
+			}
+		}
+	}
+	if len(specs) == 0 {
+		return textResult("No labels to sync."), nil, nil
+	}
+
+	targets, skipped, err := syncTargets(impl.Client, args, "label_sync", source)
+	if err != nil {
+		return nil, nil, err
+	}
+	prune, _ := args["prune"].(bool)
+	confirm, _ := args["confirm"].(bool)
+	sync := &types.LabelSync{Applied: confirm, Skipped: skipped}
+
+	for _, fullName := range targets {
+		owner, repo, _ := strings.Cut(fullName, "/")
+		scope := tools.RepoLabelScope(owner, repo)
+		existing, err := listAllLabels(impl.Client, scope)
+		if err != nil {
+			sync.Skipped = append(sync.Skipped, fmt.Sprintf("%s: failed to list labels: %v", fullName, err))
+			continue
+		}
+		changes := types.DiffLabels(fullName, existing, specs, prune)
+		if sync.Applied {
+			for _, c := range changes {
+				c.Err = applyLabelChange(impl.Client, scope, c)
+			}
+		}
+		sync.Changes = append(sync.Changes, changes...)
+	}
+	return textResult(sync.ToMarkdown()), nil, nil
+}
+
+// applyLabelChange creates, edits or deletes one label as planned.
+func applyLabelChange(client *tools.Client, scope tools.LabelScope, c *types.LabelChange) error {
+	switch c.Kind() {
+	case "create":
+		_, err := client.MyCreateLabel(scope, types.MyCreateLabelOption{
+			Name:        c.Want.Name,
+			Color:       c.Want.Color,
+			Description: c.Want.Description,
+			Exclusive:   c.Want.Exclusive,
+		})
+		return err
+	case "rename", "update":
+		_, err := client.MyEditLabel(scope, c.Existing.ID, types.MyEditLabelOption{
+			Name:        &c.Want.Name,
+			Color:       &c.Want.Color,
+			Description: &c.Want.Description,
+			Exclusive:   &c.Want.Exclusive,
+		})
+		return err
+	case "delete":
+		return client.MyDeleteLabel(scope, c.Existing.ID)
+	}
+	return nil
+}
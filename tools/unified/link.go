@@ -9,6 +9,8 @@ package unified
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
 	"github.com/google/jsonschema-go/jsonschema"
@@ -29,7 +31,7 @@ func (LinkImpl) Definition() *mcp.Tool {
 		Name:  "link_gitea",
 		Title: "Link Gitea Resources",
 		Description: `Create relationships between resources in Forgejo/Gitea.
-Types: issue_label (add labels to issue), issue_dependency (issue depends on another), issue_blocking (issue blocks another).
+Types: issue_label (add labels to one or many issues), issue_dependency (issue depends on another), issue_blocking (issue blocks another).
 Use gitea_manual(action="link") for details.`,
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:    false,
@@ -46,14 +48,14 @@ Use gitea_manual(action="link") for details.`,
 				},
 				"owner": {
 					Type:        "string",
-					Description: "Repository owner",
+					Description: "Repository owner (optional for issue_dependency and issue_blocking when index is 'owner/repo#123' or a URL)",
 				},
 				"repo": {
 					Type:        "string",
-					Description: "Repository name",
+					Description: "Repository name (optional for issue_dependency and issue_blocking when index is 'owner/repo#123' or a URL)",
 				},
 			},
-			Required:             []string{"type", "owner", "repo"},
+			Required:             []string{"type"},
 			AdditionalProperties: &jsonschema.Schema{},
 		},
 	}
@@ -73,106 +75,171 @@ func (impl LinkImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 
 		switch linkType {
 		case "issue_label":
-			return impl.addIssueLabels(args)
+			return impl.addIssueLabels(ctx, args)
 		case "issue_dependency":
 			return impl.addIssueDependency(args)
 		case "issue_blocking":
 			return impl.addIssueBlocking(args)
 		default:
-			return nil, nil, fmt.Errorf(FormatValidationError(ActionLink, linkType, "not implemented"))
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionLink, linkType, "not implemented"))
 		}
 	}
 }
 
-func (impl LinkImpl) addIssueLabels(args map[string]any) (*mcp.CallToolResult, any, error) {
+func (impl LinkImpl) addIssueLabels(ctx context.Context, args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionLink, "issue_label", err.Error()))
-	}
-
-	index, ok := args["index"].(float64)
-	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionLink, "issue_label", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionLink, "issue_label", err.Error()))
 	}
 
 	labelsRaw, ok := args["labels"].([]any)
 	if !ok || len(labelsRaw) == 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionLink, "issue_label", "labels is required (array of label IDs)"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionLink, "issue_label", "labels is required (array of label names or IDs)"))
+	}
+	labelIDs, err := resolveLabelIDs(impl.Client, owner, repo, labelsRaw)
+	if err != nil {
+		return nil, nil, resolveError(ActionLink, "issue_label", err)
 	}
 
-	labelIDs := toInt64Slice(labelsRaw)
-	opt := forgejo.IssueLabelsOption{Labels: labelIDs}
+	replaceScoped, _ := args["replace_scoped"].(bool)
+	index, ok := args["index"].(float64)
+	if (!ok || index <= 0) && isBulk(args) {
+		return issueBulk(ctx, impl.Client, ActionLink, "issue_label", args, addLabelsFunc(impl.Client, owner, repo, labelIDs, replaceScoped))
+	}
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionLink, "issue_label", "index is required"))
+	}
 
-	labels, _, err := impl.Client.AddIssueLabels(owner, repo, int64(index), opt)
+	labels, replaced, err := addIssueLabelsScoped(impl.Client, owner, repo, int64(index), labelIDs, replaceScoped)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to add labels: %w", err)
+		return nil, nil, err
 	}
 
 	labelList := make(types.LabelList, len(labels))
 	for i, l := range labels {
 		labelList[i] = &types.Label{Label: l}
 	}
-	return textResult(fmt.Sprintf("Labels added to issue #%d\n\n%s", int(index), labelList.ToMarkdown())), nil, nil
+	text := fmt.Sprintf("Labels added to issue #%d\n\n%s", int(index), labelList.ToMarkdown())
+	if len(replaced) > 0 {
+		text += "\nReplaced in the same scope: " + labelNames(replaced) + "\n"
+	}
+	if conflicts := types.ScopedLabelConflicts(labels, labelIDs); len(conflicts) > 0 {
+		text += "\n**Note**: the issue keeps other labels in the scope of an added one: " + labelNames(conflicts) +
+			". Call again with replace_scoped=true to keep only the added labels, or mark the scope's labels exclusive.\n"
+	}
+	return textResult(text), nil, nil
 }
 
-func (impl LinkImpl) addIssueDependency(args map[string]any) (*mcp.CallToolResult, any, error) {
-	owner, repo, err := extractOwnerRepo(args)
+// addIssueLabelsScoped adds labels to an issue and returns its labels
+// afterwards, plus those that were replaced: labels the server dropped
+// because an added label is exclusive in their scope, and with
+// replaceScoped any other label sharing a scope with an added one.
+func addIssueLabelsScoped(client *tools.Client, owner, repo string, index int64, labelIDs []int64, replaceScoped bool) (labels, replaced []*forgejo.Label, err error) {
+	before, _, err := client.GetIssueLabels(owner, repo, index, forgejo.ListLabelsOptions{})
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionLink, "issue_dependency", err.Error()))
+		return nil, nil, fmt.Errorf("failed to get issue labels: %w", err)
+	}
+	labels, _, err = client.AddIssueLabels(owner, repo, index, forgejo.IssueLabelsOption{Labels: labelIDs})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to add labels: %w", err)
+	}
+	for _, l := range before {
+		if !slices.ContainsFunc(labels, func(after *forgejo.Label) bool { return after.ID == l.ID }) {
+			replaced = append(replaced, l)
+		}
 	}
 
-	index, ok := args["index"].(float64)
-	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionLink, "issue_dependency", "index is required"))
+	if !replaceScoped {
+		return labels, replaced, nil
 	}
+	for _, l := range types.ScopedLabelConflicts(labels, labelIDs) {
+		if _, err := client.DeleteIssueLabel(owner, repo, index, l.ID); err != nil {
+			return nil, nil, fmt.Errorf("failed to remove label %s: %w", l.Name, err)
+		}
+		replaced = append(replaced, l)
+		labels = slices.DeleteFunc(labels, func(kept *forgejo.Label) bool { return kept.ID == l.ID })
+	}
+	return labels, replaced, nil
+}
 
-	dependencyIndex, ok := args["dependency_index"].(float64)
-	if !ok || dependencyIndex <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionLink, "issue_dependency", "dependency_index is required"))
+func labelNames(labels []*forgejo.Label) string {
+	names := make([]string, len(labels))
+	for i, l := range labels {
+		names[i] = l.Name
 	}
+	return strings.Join(names, ", ")
+}
 
-	dependency := types.MyIssueMeta{
-		Owner: owner,
-		Name:  repo,
-		Index: int64(dependencyIndex),
+func (impl LinkImpl) addIssueDependency(args map[string]any) (*mcp.CallToolResult, any, error) {
+	issue, dependency, err := issueLinkArgs(args, "dependency_index")
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionLink, "issue_dependency", err.Error()))
 	}
 
-	_, err = impl.Client.MyAddIssueDependency(owner, repo, int64(index), dependency)
+	_, err = impl.Client.MyAddIssueDependency(issue.Owner, issue.Name, issue.Index, dependency)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to add dependency: %w", err)
 	}
 
-	return textResult(fmt.Sprintf("Issue #%d now depends on issue #%d (must close #%d first)",
-		int(index), int(dependencyIndex), int(dependencyIndex))), nil, nil
+	ref := dependency.Ref(issue.Owner, issue.Name)
+	return textResult(fmt.Sprintf("Issue %s now depends on issue %s (must close %s first)",
+		issueArgRef(args, issue), ref, ref)), nil, nil
 }
 
 func (impl LinkImpl) addIssueBlocking(args map[string]any) (*mcp.CallToolResult, any, error) {
-	owner, repo, err := extractOwnerRepo(args)
+	issue, blocked, err := issueLinkArgs(args, "blocked_index")
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionLink, "issue_blocking", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionLink, "issue_blocking", err.Error()))
 	}
 
-	index, ok := args["index"].(float64)
-	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionLink, "issue_blocking", "index is required"))
+	_, err = impl.Client.MyAddIssueBlocking(issue.Owner, issue.Name, issue.Index, blocked)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to add blocking relationship: %w", err)
 	}
 
-	blockedIndex, ok := args["blocked_index"].(float64)
-	if !ok || blockedIndex <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionLink, "issue_blocking", "blocked_index is required"))
-	}
+	ref := issueArgRef(args, issue)
+	return textResult(fmt.Sprintf("Issue %s now blocks issue %s (must close %s first)",
+		ref, blocked.Ref(issue.Owner, issue.Name), ref)), nil, nil
+}
 
-	blocked := types.MyIssueMeta{
-		Owner: owner,
-		Name:  repo,
-		Index: int64(blockedIndex),
+// issueRefArg reads an issue given either as a number in owner/repo or as a
+// reference accepted by types.ParseIssueRef.
+func issueRefArg(args map[string]any, key, owner, repo string) (types.MyIssueMeta, error) {
+	var meta types.MyIssueMeta
+	switch v := args[key].(type) {
+	case float64:
+		meta = types.MyIssueMeta{Owner: owner, Name: repo, Index: int64(v)}
+	case string:
+		var err error
+		if meta, err = types.ParseIssueRef(v, owner, repo); err != nil {
+			return meta, err
+		}
 	}
-
-	_, err = impl.Client.MyAddIssueBlocking(owner, repo, int64(index), blocked)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to add blocking relationship: %w", err)
+	if meta.Index <= 0 {
+		return meta, fmt.Errorf("%s is required", key)
+	}
+	if meta.Owner == "" || meta.Name == "" {
+		return meta, fmt.Errorf("owner and repo are required unless %s is a full reference like owner/repo#123", key)
 	}
+	return meta, nil
+}
+
+// issueLinkArgs reads both sides of a dependency or blocking link: the issue
+// at index and the one at otherKey, which defaults to the repository of the
+// first.
+func issueLinkArgs(args map[string]any, otherKey string) (issue, other types.MyIssueMeta, err error) {
+	owner, _ := args["owner"].(string)
+	repo, _ := args["repo"].(string)
+	if issue, err = issueRefArg(args, "index", owner, repo); err != nil {
+		return issue, other, err
+	}
+	other, err = issueRefArg(args, otherKey, issue.Owner, issue.Name)
+	return issue, other, err
+}
 
-	return textResult(fmt.Sprintf("Issue #%d now blocks issue #%d (must close #%d first)",
-		int(index), int(blockedIndex), int(index))), nil, nil
+// issueArgRef renders issue relative to the owner and repo arguments.
+func issueArgRef(args map[string]any, issue types.MyIssueMeta) string {
+	owner, _ := args["owner"].(string)
+	repo, _ := args["repo"].(string)
+	return issue.Ref(owner, repo)
 }
@@ -20,6 +20,13 @@ import (
 	"github.com/raohwork/forgejo-mcp/types"
 )
 
+const (
+	// timelinePageSize is the page size used when loading a whole timeline.
+	timelinePageSize = 50
+	// timelineMaxPages bounds the events loaded for a single issue.
+	timelineMaxPages = 20
+)
+
 // ListImpl implements the list_gitea tool.
 type ListImpl struct {
 	Client *tools.Client
@@ -31,7 +38,7 @@ func (ListImpl) Definition() *mcp.Tool {
 		Name:  "list_gitea",
 		Title: "List Gitea Resources",
 		Description: `List resources from Forgejo/Gitea with filtering.
-Resources: issue, issue_comment, issue_attachment, label, milestone, release, release_attachment, wiki_page, pull_request, repository, action_task, issue_dependency, issue_blocking.
+Resources: issue, issue_search, issue_comment, issue_timeline, issue_template, issue_subscription, reaction, tracked_time, stopwatch, issue_attachment, label, milestone, release, release_attachment, wiki_page, pull_request, repository, repo_watch, action_task, action_workflow, action_artifact, action_variable, issue_dependency, issue_blocking.
 Use gitea_manual(action="list") for details.`,
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:   true,
@@ -44,19 +51,20 @@ Use gitea_manual(action="list") for details.`,
 					Type:        "string",
 					Description: "Resource type to list",
 					Enum: []any{
-						"issue", "issue_comment", "issue_attachment", "label",
+						"issue", "issue_search", "issue_comment", "issue_timeline", "issue_template", "issue_subscription", "reaction", "tracked_time", "stopwatch", "issue_attachment", "label",
 						"milestone", "release", "release_attachment", "wiki_page",
-						"pull_request", "repository", "action_task",
+						"pull_request", "repository", "repo_watch", "action_task", "action_workflow", "action_artifact",
+						"action_variable",
 						"issue_dependency", "issue_blocking",
 					},
 				},
 				"owner": {
 					Type:        "string",
-					Description: "Repository owner (not required for repository with scope='my')",
+					Description: "Repository owner (not required for repository with scope='my', issue_search, stopwatch, repo_watch or org/user scoped resources)",
 				},
 				"repo": {
 					Type:        "string",
-					Description: "Repository name (not required for repository listing)",
+					Description: "Repository name (not required for repository listing, issue_search, stopwatch, repo_watch or org/user scoped resources)",
 				},
 			},
 			Required:             []string{"resource"},
@@ -82,8 +90,22 @@ func (impl ListImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 		switch resource {
 		case "issue":
 			return impl.listIssues(args)
+		case "issue_search":
+			return impl.searchIssues(args)
 		case "issue_comment":
 			return impl.listIssueComments(args)
+		case "issue_timeline":
+			return impl.listIssueTimeline(args)
+		case "issue_template":
+			return impl.listIssueTemplates(args)
+		case "issue_subscription":
+			return impl.listIssueSubscriptions(args)
+		case "reaction":
+			return impl.listReactions(args)
+		case "tracked_time":
+			return impl.listTrackedTimes(args)
+		case "stopwatch":
+			return impl.listStopwatches(args)
 		case "issue_attachment":
 			return impl.listIssueAttachments(args)
 		case "label":
@@ -100,14 +122,22 @@ func (impl ListImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 			return impl.listPullRequests(args)
 		case "repository":
 			return impl.listRepositories(args)
+		case "repo_watch":
+			return impl.listRepoWatches(args)
 		case "action_task":
 			return impl.listActionTasks(args)
+		case "action_workflow":
+			return impl.listActionWorkflows(args)
+		case "action_artifact":
+			return impl.listActionArtifacts(args)
+		case "action_variable":
+			return impl.listActionVariables(args)
 		case "issue_dependency":
 			return impl.listIssueDependencies(args)
 		case "issue_blocking":
 			return impl.listIssueBlocking(args)
 		default:
-			return nil, nil, fmt.Errorf(FormatValidationError(ActionList, resource, "not implemented"))
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, resource, "not implemented"))
 		}
 	}
 }
@@ -115,9 +145,34 @@ func (impl ListImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 func (impl ListImpl) listIssues(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "issue", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue", err.Error()))
+	}
+
+	opt, err := issueListOptions(args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues, _, err := impl.Client.ListRepoIssues(owner, repo, opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list issues: %w", err)
 	}
 
+	pinned, err := impl.Client.MyListPinnedIssues(owner, repo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list pinned issues: %w", err)
+	}
+	issueList := types.PinnedIssueList{Issues: issues, Pinned: map[int64]bool{}}
+	for _, issue := range pinned {
+		issueList.Pinned[issue.Index] = true
+	}
+	content := fmt.Sprintf("Found %d issues\n\n%s", len(issues), issueList.ToMarkdown())
+	return textResult(content), nil, nil
+}
+
+// issueListOptions builds the filters of list:issue, which bulk operations
+// accept too.
+func issueListOptions(args map[string]any) (forgejo.ListIssueOption, error) {
 	opt := forgejo.ListIssueOption{}
 
 	if state, ok := args["state"].(string); ok && state != "" {
@@ -144,37 +199,86 @@ func (impl ListImpl) listIssues(args map[string]any) (*mcp.CallToolResult, any,
 	if sinceStr, ok := args["since"].(string); ok && sinceStr != "" {
 		since, err := time.Parse(time.RFC3339, sinceStr)
 		if err != nil {
-			return nil, nil, fmt.Errorf("invalid since format (expected RFC3339): %w", err)
+			return opt, fmt.Errorf("invalid since format (expected RFC3339): %w", err)
 		}
 		opt.Since = since
 	}
 	if beforeStr, ok := args["before"].(string); ok && beforeStr != "" {
 		before, err := time.Parse(time.RFC3339, beforeStr)
 		if err != nil {
-			return nil, nil, fmt.Errorf("invalid before format (expected RFC3339): %w", err)
+			return opt, fmt.Errorf("invalid before format (expected RFC3339): %w", err)
 		}
 		opt.Before = before
 	}
 
-	issues, _, err := impl.Client.ListRepoIssues(owner, repo, opt)
+	return opt, nil
+}
+
+func (impl ListImpl) searchIssues(args map[string]any) (*mcp.CallToolResult, any, error) {
+	opt := types.MyIssueSearchOption{}
+	opt.Type, _ = args["type"].(string)
+	switch opt.Type {
+	case "", "issues", "pulls":
+	case "issue":
+		opt.Type = "issues"
+	case "pr", "pull", "pull_request":
+		opt.Type = "pulls"
+	default:
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_search", "type must be 'issues' or 'pulls'"))
+	}
+
+	opt.State, _ = args["state"].(string)
+	if labels, ok := args["labels"].(string); ok && labels != "" {
+		opt.Labels = strings.Split(labels, ",")
+	}
+	if milestones, ok := args["milestones"].(string); ok && milestones != "" {
+		opt.Milestones = strings.Split(milestones, ",")
+	}
+	opt.Query, _ = args["q"].(string)
+	opt.Assigned, _ = args["assigned"].(bool)
+	opt.Created, _ = args["created"].(bool)
+	opt.Mentioned, _ = args["mentioned"].(bool)
+	opt.ReviewRequested, _ = args["review_requested"].(bool)
+	opt.Owner, _ = args["owner"].(string)
+	opt.Team, _ = args["team"].(string)
+	if opt.Team != "" && opt.Owner == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_search", "team requires owner (the organization)"))
+	}
+	if sinceStr, ok := args["since"].(string); ok && sinceStr != "" {
+		since, err := time.Parse(time.RFC3339, sinceStr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid since format (expected RFC3339): %w", err)
+		}
+		opt.Since = since
+	}
+	if page, ok := args["page"].(float64); ok && page > 0 {
+		opt.Page = int(page)
+	}
+	if limit, ok := args["limit"].(float64); ok && limit > 0 {
+		opt.Limit = int(limit)
+	}
+
+	issues, err := impl.Client.MySearchIssues(opt)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to list issues: %w", err)
+		return nil, nil, fmt.Errorf("failed to search issues: %w", err)
 	}
 
-	issueList := types.IssueList(issues)
-	content := fmt.Sprintf("Found %d issues\n\n%s", len(issues), issueList.ToMarkdown())
-	return textResult(content), nil, nil
+	if len(issues) == 0 {
+		return textResult("No issues found."), nil, nil
+	}
+
+	return textResult(fmt.Sprintf("Found %d issues\n\n%s", len(issues), types.IssueSearchList(issues).ToMarkdown())), nil, nil
 }
 
 func (impl ListImpl) listIssueComments(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "issue_comment", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_comment", err.Error()))
 	}
 
 	index, ok := args["index"].(float64)
 	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "issue_comment", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_comment", "index is required"))
 	}
 
 	opt := forgejo.ListIssueCommentOptions{}
@@ -210,15 +314,78 @@ func (impl ListImpl) listIssueComments(args map[string]any) (*mcp.CallToolResult
 	return textResult(fmt.Sprintf("Found %d comments\n\n%s", len(comments), sb.String())), nil, nil
 }
 
+func (impl ListImpl) listIssueTimeline(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_timeline", err.Error()))
+	}
+
+	index, ok := args["index"].(float64)
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_timeline", "index is required"))
+	}
+
+	since, err := parseDateArg(args, "since")
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_timeline", err.Error()))
+	}
+	before, err := parseDateArg(args, "before")
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_timeline", err.Error()))
+	}
+
+	var (
+		events   types.IssueTimeline
+		complete = true
+	)
+	if page, ok := args["page"].(float64); ok && page > 0 {
+		limit, _ := args["limit"].(float64)
+		events, err = impl.Client.MyListIssueTimeline(owner, repo, int64(index), int(page), int(limit), since, before)
+	} else {
+		events, complete, err = impl.listAllIssueTimeline(owner, repo, int64(index), since, before)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list timeline: %w", err)
+	}
+
+	if len(events) == 0 {
+		return textResult("No events found for this issue."), nil, nil
+	}
+
+	text := fmt.Sprintf("Found %d events\n\n%s", len(events), events.ToMarkdown())
+	if !complete {
+		text += fmt.Sprintf("\n*Only the first %d events were loaded, the latest ones are missing. Pass since, or page=%d with limit=%d, to read on.*\n",
+			timelinePageSize*timelineMaxPages, timelineMaxPages+1, timelinePageSize)
+	}
+	return textResult(text), nil, nil
+}
+
+// listAllIssueTimeline pages through the whole timeline, up to
+// timelineMaxPages pages. The timeline is oldest first, so complete is false
+// when the latest events were left out.
+func (impl ListImpl) listAllIssueTimeline(owner, repo string, index int64, since, before time.Time) (ret types.IssueTimeline, complete bool, err error) {
+	for page := 1; page <= timelineMaxPages; page++ {
+		events, err := impl.Client.MyListIssueTimeline(owner, repo, index, page, timelinePageSize, since, before)
+		if err != nil {
+			return nil, false, err
+		}
+		ret = append(ret, events...)
+		if len(events) < timelinePageSize {
+			return ret, true, nil
+		}
+	}
+	return ret, false, nil
+}
+
 func (impl ListImpl) listIssueAttachments(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "issue_attachment", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_attachment", err.Error()))
 	}
 
 	index, ok := args["index"].(float64)
 	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "issue_attachment", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_attachment", "index is required"))
 	}
 
 	attachments, err := impl.Client.MyListIssueAttachments(owner, repo, int64(index))
@@ -238,31 +405,27 @@ func (impl ListImpl) listIssueAttachments(args map[string]any) (*mcp.CallToolRes
 }
 
 func (impl ListImpl) listLabels(args map[string]any) (*mcp.CallToolResult, any, error) {
-	owner, repo, err := extractOwnerRepo(args)
+	scope, where, err := extractLabelScope(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "label", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "label", err.Error()))
 	}
 
-	labels, _, err := impl.Client.ListRepoLabels(owner, repo, forgejo.ListLabelsOptions{})
+	labels, err := listAllLabels(impl.Client, scope)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to list labels: %w", err)
 	}
 
 	if len(labels) == 0 {
-		return textResult("No labels found in this repository."), nil, nil
+		return textResult(fmt.Sprintf("No labels found in %s.", where)), nil, nil
 	}
 
-	labelList := make(types.LabelList, len(labels))
-	for i, label := range labels {
-		labelList[i] = &types.Label{Label: label}
-	}
-	return textResult(fmt.Sprintf("Found %d labels\n\n%s", len(labels), labelList.ToMarkdown())), nil, nil
+	return textResult(fmt.Sprintf("Found %d labels in %s\n\n%s", len(labels), where, types.MyLabelList(labels).ToMarkdown())), nil, nil
 }
 
 func (impl ListImpl) listMilestones(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "milestone", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "milestone", err.Error()))
 	}
 
 	opt := forgejo.ListMilestoneOption{}
@@ -298,7 +461,7 @@ func (impl ListImpl) listMilestones(args map[string]any) (*mcp.CallToolResult, a
 func (impl ListImpl) listReleases(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "release", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "release", err.Error()))
 	}
 
 	opt := forgejo.ListReleasesOptions{}
@@ -328,12 +491,12 @@ func (impl ListImpl) listReleases(args map[string]any) (*mcp.CallToolResult, any
 func (impl ListImpl) listReleaseAttachments(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "release_attachment", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "release_attachment", err.Error()))
 	}
 
 	id, ok := args["id"].(float64)
 	if !ok || id <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "release_attachment", "id is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "release_attachment", "id is required"))
 	}
 
 	attachments, _, err := impl.Client.ListReleaseAttachments(owner, repo, int64(id), forgejo.ListReleaseAttachmentsOptions{})
@@ -355,7 +518,7 @@ func (impl ListImpl) listReleaseAttachments(args map[string]any) (*mcp.CallToolR
 func (impl ListImpl) listWikiPages(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "wiki_page", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "wiki_page", err.Error()))
 	}
 
 	pages, err := impl.Client.MyListWikiPages(owner, repo)
@@ -374,27 +537,28 @@ func (impl ListImpl) listWikiPages(args map[string]any) (*mcp.CallToolResult, an
 func (impl ListImpl) listPullRequests(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "pull_request", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "pull_request", err.Error()))
 	}
 
-	opt := forgejo.ListPullRequestsOptions{}
-	if state, ok := args["state"].(string); ok && state != "" {
-		opt.State = forgejo.StateType(state)
-	}
-	if sort, ok := args["sort"].(string); ok && sort != "" {
-		opt.Sort = sort
+	opt := types.MyPullRequestListOption{}
+	opt.State, _ = args["state"].(string)
+	opt.Sort, _ = args["sort"].(string)
+	if opt.Milestone, _, err = resolveMilestoneID(impl.Client, owner, repo, args["milestone"]); err != nil {
+		return nil, nil, resolveError(ActionList, "pull_request", err)
 	}
-	if milestone, ok := args["milestone"].(float64); ok && milestone > 0 {
-		opt.Milestone = int64(milestone)
+	if labels, ok := args["labels"].([]any); ok {
+		if opt.Labels, err = resolveLabelIDs(impl.Client, owner, repo, labels); err != nil {
+			return nil, nil, resolveError(ActionList, "pull_request", err)
+		}
 	}
 	if page, ok := args["page"].(float64); ok && page > 0 {
 		opt.Page = int(page)
 	}
 	if limit, ok := args["limit"].(float64); ok && limit > 0 {
-		opt.PageSize = int(limit)
+		opt.Limit = int(limit)
 	}
 
-	prs, _, err := impl.Client.ListRepoPullRequests(owner, repo, opt)
+	prs, err := impl.Client.MyListPullRequests(owner, repo, opt)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to list pull requests: %w", err)
 	}
@@ -413,7 +577,7 @@ func (impl ListImpl) listPullRequests(args map[string]any) (*mcp.CallToolResult,
 func (impl ListImpl) listRepositories(args map[string]any) (*mcp.CallToolResult, any, error) {
 	scope, _ := args["scope"].(string)
 	if scope == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "repository", "scope is required ('my', 'org', or 'search')"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "repository", "scope is required ('my', 'org', or 'search')"))
 	}
 
 	switch scope {
@@ -424,7 +588,7 @@ func (impl ListImpl) listRepositories(args map[string]any) (*mcp.CallToolResult,
 	case "search":
 		return impl.searchRepositories(args)
 	default:
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "repository", "scope must be 'my', 'org', or 'search'"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "repository", "scope must be 'my', 'org', or 'search'"))
 	}
 }
 
@@ -456,7 +620,7 @@ func (impl ListImpl) listMyRepositories(args map[string]any) (*mcp.CallToolResul
 func (impl ListImpl) listOrgRepositories(args map[string]any) (*mcp.CallToolResult, any, error) {
 	org, _ := args["org"].(string)
 	if org == "" {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "repository", "org is required for scope='org'"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "repository", "org is required for scope='org'"))
 	}
 
 	opt := forgejo.ListOrgReposOptions{}
@@ -519,7 +683,7 @@ func (impl ListImpl) searchRepositories(args map[string]any) (*mcp.CallToolResul
 func (impl ListImpl) listActionTasks(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "action_task", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "action_task", err.Error()))
 	}
 
 	response, err := impl.Client.MyListActionTasks(owner, repo)
@@ -535,15 +699,58 @@ func (impl ListImpl) listActionTasks(args map[string]any) (*mcp.CallToolResult,
 	return textResult(fmt.Sprintf("Found %d action tasks\n\n%s", response.TotalCount, taskList.ToMarkdown())), nil, nil
 }
 
+func (impl ListImpl) listActionArtifacts(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "action_artifact", err.Error()))
+	}
+
+	var response *types.MyActionArtifactResponse
+	if runID, ok := args["run_id"].(float64); ok && runID > 0 {
+		response, err = impl.Client.MyListActionRunArtifacts(owner, repo, int64(runID))
+	} else {
+		response, err = impl.Client.MyListActionArtifacts(owner, repo)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+
+	if len(response.Artifacts) == 0 {
+		return textResult("No artifacts found."), nil, nil
+	}
+
+	list := types.ActionArtifactList{MyActionArtifactResponse: response}
+	return textResult(fmt.Sprintf("Found %d artifacts\n\n%s", len(response.Artifacts), list.ToMarkdown())), nil, nil
+}
+
+func (impl ListImpl) listActionVariables(args map[string]any) (*mcp.CallToolResult, any, error) {
+	scope, where, err := extractActionScope(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "action_variable", err.Error()))
+	}
+
+	variables, err := impl.Client.MyListActionVariables(scope)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list variables: %w", err)
+	}
+
+	if len(variables) == 0 {
+		return textResult(fmt.Sprintf("No variables found in %s.", where)), nil, nil
+	}
+
+	list := types.ActionVariableList(variables)
+	return textResult(fmt.Sprintf("Found %d variables in %s\n\n%s", len(variables), where, list.ToMarkdown())), nil, nil
+}
+
 func (impl ListImpl) listIssueDependencies(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "issue_dependency", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_dependency", err.Error()))
 	}
 
 	index, ok := args["index"].(float64)
 	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "issue_dependency", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_dependency", "index is required"))
 	}
 
 	issues, err := impl.Client.MyListIssueDependencies(owner, repo, int64(index))
@@ -558,12 +765,12 @@ func (impl ListImpl) listIssueDependencies(args map[string]any) (*mcp.CallToolRe
 func (impl ListImpl) listIssueBlocking(args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "issue_blocking", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_blocking", err.Error()))
 	}
 
 	index, ok := args["index"].(float64)
 	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionList, "issue_blocking", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_blocking", "index is required"))
 	}
 
 	issues, err := impl.Client.MyListIssueBlocking(owner, repo, int64(index))
@@ -574,3 +781,31 @@ func (impl ListImpl) listIssueBlocking(args map[string]any) (*mcp.CallToolResult
 	blocking := types.IssueBlockingList(issues)
 	return textResult(fmt.Sprintf("## Issues blocked by #%d\n\n%s", int(index), blocking.ToMarkdown())), nil, nil
 }
+
+func (impl ListImpl) listReactions(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "reaction", err.Error()))
+	}
+
+	index, commentID, what, err := extractReactionTarget(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "reaction", err.Error()))
+	}
+
+	var reactions []*forgejo.Reaction
+	if commentID > 0 {
+		reactions, _, err = impl.Client.GetIssueCommentReactions(owner, repo, commentID)
+	} else {
+		reactions, _, err = impl.Client.GetIssueReactions(owner, repo, index)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list reactions: %w", err)
+	}
+
+	if len(reactions) == 0 {
+		return textResult(fmt.Sprintf("No reactions on %s.", what)), nil, nil
+	}
+
+	return textResult(fmt.Sprintf("%d reactions on %s\n\n%s", len(reactions), what, types.ReactionList(reactions).ToMarkdown())), nil, nil
+}
@@ -0,0 +1,159 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/tools"
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// maxSyncRepos bounds the target repositories of one sync.
+const maxSyncRepos = 100
+
+// editMilestoneSync creates or updates milestones by title in a set of
+// repositories, from a source repository or a YAML spec. Without confirm it
+// only reports what would change.
+func (impl EditImpl) editMilestoneSync(args map[string]any) (*mcp.CallToolResult, any, error) {
+	var titles []string
+	if arr, ok := args["titles"].([]any); ok {
+		titles = toStringSlice(arr)
+	}
+
+	source := ""
+	var specs []types.MilestoneSpec
+	if spec, _ := args["spec"].(string); spec != "" {
+		var err error
+		if specs, err = types.ParseMilestoneSpecs([]byte(spec)); err != nil {
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "milestone_sync", err.Error()))
+		}
+	} else {
+		owner, repo, err := extractOwnerRepo(args)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "milestone_sync", "either spec or the source owner and repo are required"))
+		}
+		source = owner + "/" + repo
+		milestones, err := listAllRepoMilestones(impl.Client, owner, repo)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list milestones of %s: %w", source, err)
+		}
+		// closed milestones are copied when asked for, or picked by title
+		includeClosed, _ := args["include_closed"].(bool)
+		for _, m := range milestones {
+			if includeClosed || len(titles) > 0 || m.State != forgejo.StateClosed {
+				specs = append(specs, types.MilestoneSpecOf(m))
+			}
+		}
+	}
+
+	if len(titles) > 0 {
+		specs = slices.DeleteFunc(specs, func(s types.MilestoneSpec) bool {
+			return !slices.Contains(titles, s.Title)
+		})
+		for _, title := range titles {
+			if !slices.ContainsFunc(specs, func(s types.MilestoneSpec) bool { return s.Title == title }) {
+				return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "milestone_sync", fmt.Sprintf("milestone '%s' is not in the source", title)))
+			}
+		}
+	}
+	if len(specs) == 0 {
+		return textResult("No milestones to sync."), nil, nil
+	}
+
+	targets, skipped, err := syncTargets(impl.Client, args, "milestone_sync", source)
+	if err != nil {
+		return nil, nil, err
+	}
+	confirm, _ := args["confirm"].(bool)
+	sync := &types.MilestoneSync{Applied: confirm, Skipped: skipped}
+
+	for _, fullName := range targets {
+		owner, repo, _ := strings.Cut(fullName, "/")
+		existing, err := listAllRepoMilestones(impl.Client, owner, repo)
+		if err != nil {
+			sync.Skipped = append(sync.Skipped, fmt.Sprintf("%s: failed to list milestones: %v", fullName, err))
+			continue
+		}
+		changes := types.DiffMilestones(fullName, existing, specs)
+		if sync.Applied {
+			for _, c := range changes {
+				c.Err = applyMilestoneChange(impl.Client, owner, repo, c)
+			}
+		}
+		sync.Changes = append(sync.Changes, changes...)
+	}
+	return textResult(sync.ToMarkdown()), nil, nil
+}
+
+// syncTargets resolves the repos and org arguments of a sync into the
+// sorted target repositories, leaving out the source. Archived repositories
+// of the org are returned separately as skipped.
+func syncTargets(client *tools.Client, args map[string]any, resource, source string) (targets, skipped []string, err error) {
+	if arr, ok := args["repos"].([]any); ok {
+		targets = toStringSlice(arr)
+		if err := checkRepoNames(targets); err != nil {
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, resource, err.Error()))
+		}
+	}
+	if org, _ := args["org"].(string); org != "" {
+		opt := forgejo.ListOrgReposOptions{ListOptions: forgejo.ListOptions{PageSize: listPageSize}}
+		for opt.Page = 1; ; opt.Page++ {
+			page, _, err := client.ListOrgRepos(org, opt)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list repositories of %s: %w", org, err)
+			}
+			for _, r := range page {
+				if r.Archived {
+					skipped = append(skipped, r.FullName+": archived")
+				} else {
+					targets = append(targets, r.FullName)
+				}
+			}
+			if len(page) < listPageSize {
+				break
+			}
+		}
+	}
+
+	slices.Sort(targets)
+	targets = slices.DeleteFunc(slices.Compact(targets), func(t string) bool { return t == source })
+	switch {
+	case len(targets) == 0:
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, resource, "repos or org must name at least one repository other than the source"))
+	case len(targets) > maxSyncRepos:
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, resource, fmt.Sprintf("too many target repositories (%d), at most %d are allowed", len(targets), maxSyncRepos)))
+	}
+	return targets, skipped, nil
+}
+
+// applyMilestoneChange creates or updates one milestone as planned.
+func applyMilestoneChange(client *tools.Client, owner, repo string, c *types.MilestoneChange) error {
+	if c.Create() {
+		_, _, err := client.CreateMilestone(owner, repo, forgejo.CreateMilestoneOption{
+			Title:       c.Want.Title,
+			Description: c.Want.Description,
+			State:       c.Want.State,
+			Deadline:    c.Want.Deadline,
+		})
+		return err
+	}
+	if len(c.Changes) == 0 {
+		return nil
+	}
+	opt := forgejo.EditMilestoneOption{Title: c.Want.Title, State: &c.Want.State, Deadline: c.Want.Deadline}
+	if c.Want.Description != "" {
+		opt.Description = &c.Want.Description
+	}
+	_, _, err := client.EditMilestone(owner, repo, c.Existing.ID, opt)
+	return err
+}
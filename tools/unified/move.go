@@ -0,0 +1,190 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/tools"
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// listPageSize is the page size used when loading complete lists of labels,
+// milestones or comments.
+const listPageSize = 50
+
+// listAllRepoMilestones loads every milestone of a repository, open or
+// closed.
+func listAllRepoMilestones(client *tools.Client, owner, repo string) ([]*forgejo.Milestone, error) {
+	var ret []*forgejo.Milestone
+	opt := forgejo.ListMilestoneOption{ListOptions: forgejo.ListOptions{PageSize: listPageSize}, State: forgejo.StateAll}
+	for opt.Page = 1; ; opt.Page++ {
+		milestones, _, err := client.ListRepoMilestones(owner, repo, opt)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, milestones...)
+		if len(milestones) < listPageSize {
+			return ret, nil
+		}
+	}
+}
+
+// listAllIssueComments loads every comment of an issue.
+func listAllIssueComments(client *tools.Client, owner, repo string, index int64) ([]*forgejo.Comment, error) {
+	var ret []*forgejo.Comment
+	opt := forgejo.ListIssueCommentOptions{ListOptions: forgejo.ListOptions{PageSize: listPageSize}}
+	for opt.Page = 1; ; opt.Page++ {
+		comments, _, err := client.ListIssueComments(owner, repo, index, opt)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, comments...)
+		if len(comments) < listPageSize {
+			return ret, nil
+		}
+	}
+}
+
+// createIssueMove recreates an issue in another repository. Forgejo cannot
+// transfer issues, so labels and the milestone are mapped by name, comments
+// are copied quoting their authors, and the original is linked and closed.
+func (impl CreateImpl) createIssueMove(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue_move", err.Error()))
+	}
+
+	index, ok := args["index"].(float64)
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue_move", "index is required"))
+	}
+
+	targetOwner, _ := args["target_owner"].(string)
+	if targetOwner == "" {
+		targetOwner = owner
+	}
+	targetRepo, _ := args["target_repo"].(string)
+	if targetRepo == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue_move", "target_repo is required"))
+	}
+	if targetOwner == owner && targetRepo == repo {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue_move", "target repository must differ from the source"))
+	}
+	keepOpen, _ := args["keep_open"].(bool)
+
+	issue, _, err := impl.Client.GetIssue(owner, repo, int64(index))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+	if issue.PullRequest != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue_move", "pull requests cannot be moved"))
+	}
+	comments, err := listAllIssueComments(impl.Client, owner, repo, issue.Index)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	source := fmt.Sprintf("%s/%s", owner, repo)
+	result := &types.IssueMove{Source: fmt.Sprintf("%s#%d", source, issue.Index)}
+	opt := forgejo.CreateIssueOption{
+		Title:    issue.Title,
+		Body:     types.MovedIssueBody(issue, source),
+		Deadline: issue.Deadline,
+		Closed:   issue.State == forgejo.StateClosed,
+	}
+
+	if len(issue.Labels) > 0 {
+		names := make([]string, 0, len(issue.Labels))
+		for _, l := range issue.Labels {
+			names = append(names, l.Name)
+		}
+		opt.Labels, result.UnmappedLabels, err = labelIDsByName(impl.Client, targetOwner, targetRepo, names)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to map labels: %w", err)
+		}
+	}
+
+	if issue.Milestone != nil {
+		milestones, err := listAllRepoMilestones(impl.Client, targetOwner, targetRepo)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list target milestones: %w", err)
+		}
+		result.UnmappedMilestone = issue.Milestone.Title
+		for _, m := range milestones {
+			if m.Title == issue.Milestone.Title {
+				opt.Milestone = m.ID
+				result.UnmappedMilestone = ""
+				break
+			}
+		}
+	}
+
+	if len(issue.Assignees) > 0 {
+		// assignees must be assignable in the target; when that cannot be
+		// checked, keep them and rely on the retry below
+		assignable, err := assigneeNameCache.get(impl.Client, targetOwner, targetRepo, false)
+		for _, u := range issue.Assignees {
+			ok := slices.ContainsFunc(assignable, func(a named) bool { return strings.EqualFold(a.Name, u.UserName) })
+			if err == nil && !ok {
+				result.DroppedAssignees = append(result.DroppedAssignees, u.UserName)
+				continue
+			}
+			opt.Assignees = append(opt.Assignees, u.UserName)
+		}
+	}
+
+	created, resp, err := impl.Client.CreateIssue(targetOwner, targetRepo, opt)
+	if err != nil && len(opt.Assignees) > 0 && resp != nil && resp.StatusCode == http.StatusUnprocessableEntity {
+		// an assignee was rejected after all, retry without them
+		result.DroppedAssignees = append(result.DroppedAssignees, opt.Assignees...)
+		opt.Assignees = nil
+		created, _, err = impl.Client.CreateIssue(targetOwner, targetRepo, opt)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create issue in %s/%s: %w", targetOwner, targetRepo, err)
+	}
+	result.Target = fmt.Sprintf("%s/%s#%d", targetOwner, targetRepo, created.Index)
+	result.TargetURL = created.HTMLURL
+
+	for _, c := range comments {
+		_, _, err := impl.Client.CreateIssueComment(targetOwner, targetRepo, created.Index, forgejo.CreateIssueCommentOption{
+			Body: types.MovedCommentBody(c),
+		})
+		if err != nil {
+			result.Problems = append(result.Problems, fmt.Sprintf("failed to copy comment %d: %v", c.ID, err))
+			continue
+		}
+		result.Comments++
+	}
+
+	_, _, err = impl.Client.CreateIssueComment(owner, repo, issue.Index, forgejo.CreateIssueCommentOption{
+		Body: "Moved to " + result.Target,
+	})
+	if err != nil {
+		result.Problems = append(result.Problems, fmt.Sprintf("failed to link the original issue: %v", err))
+	}
+
+	result.Closed = issue.State == forgejo.StateClosed
+	if !keepOpen && !result.Closed {
+		state := forgejo.StateClosed
+		_, _, err = impl.Client.EditIssue(owner, repo, issue.Index, forgejo.EditIssueOption{State: &state})
+		if err != nil {
+			result.Problems = append(result.Problems, fmt.Sprintf("failed to close the original issue: %v", err))
+		} else {
+			result.Closed = true
+		}
+	}
+
+	return textResult(result.ToMarkdown()), nil, nil
+}
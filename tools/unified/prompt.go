@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/tools"
+)
+
+// RegisterPrompts registers the MCP prompts with the server.
+func RegisterPrompts(s *mcp.Server, cl *tools.Client) {
+	s.AddPrompt(&mcp.Prompt{
+		Name:        "standup",
+		Title:       "Stand-up deadline review",
+		Description: "Review overdue and due-soon issues per assignee and milestone for a stand-up meeting.",
+		Arguments: []*mcp.PromptArgument{
+			{Name: "repos", Description: "Comma-separated repositories as owner/repo", Required: true},
+			{Name: "days", Description: fmt.Sprintf("Days ahead counted as due soon (default %d)", defaultReportDays)},
+		},
+	}, standupPrompt(cl))
+}
+
+// standupPrompt embeds the deadline report of the requested repositories in
+// a request to prepare the stand-up agenda.
+func standupPrompt(cl *tools.Client) mcp.PromptHandler {
+	return func(ctx context.Context, req *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		var repos []string
+		for _, r := range strings.Split(req.Params.Arguments["repos"], ",") {
+			if r = strings.TrimSpace(r); r != "" {
+				repos = append(repos, r)
+			}
+		}
+		if len(repos) == 0 {
+			return nil, errors.New("repos is required")
+		}
+		if err := checkRepoNames(repos); err != nil {
+			return nil, err
+		}
+
+		days := defaultReportDays
+		if s := req.Params.Arguments["days"]; s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil || n < 0 {
+				return nil, errors.New("days must be a non-negative number")
+			}
+			days = min(n, maxReportDays)
+		}
+
+		report, truncated, err := loadDeadlineReport(cl, repos, days)
+		if err != nil {
+			return nil, err
+		}
+
+		text := "Prepare the agenda of our stand-up meeting from the deadline report below. " +
+			"For each assignee, summarize what is overdue and what is due soon, most urgent first, keeping the issue links. " +
+			"Call out unassigned issues and milestones at risk, and suggest which issues need a new due date or owner.\n\n" +
+			deadlineReportText(report, truncated)
+		return &mcp.GetPromptResult{
+			Description: "Deadline review of " + strings.Join(repos, ", "),
+			Messages: []*mcp.PromptMessage{
+				{Role: "user", Content: &mcp.TextContent{Text: text}},
+			},
+		}, nil
+	}
+}
@@ -21,6 +21,8 @@ import (
 // - delete_gitea: Delete resources
 // - link_gitea: Create relationships
 // - unlink_gitea: Remove relationships
+//
+// Prompts are registered too, see RegisterPrompts.
 func RegisterAll(s *mcp.Server, cl *tools.Client) {
 	tools.Register(s, &ManualImpl{Client: cl})
 	tools.Register(s, &CreateImpl{Client: cl})
@@ -30,4 +32,5 @@ func RegisterAll(s *mcp.Server, cl *tools.Client) {
 	tools.Register(s, &DeleteImpl{Client: cl})
 	tools.Register(s, &LinkImpl{Client: cl})
 	tools.Register(s, &UnlinkImpl{Client: cl})
+	RegisterPrompts(s, cl)
 }
@@ -0,0 +1,245 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/tools"
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// maxReleaseNotesPulls bounds the merged pull requests of one release.
+const maxReleaseNotesPulls = 300
+
+// createReleaseNotes collects the merged pull requests and the other commits
+// between two refs, or the merged pull requests of a milestone, and renders
+// them as release notes. With publish the notes become the body of the
+// release of the tag, which is created when missing.
+func (impl CreateImpl) createReleaseNotes(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "release_notes", err.Error()))
+	}
+
+	groupBy, _ := args["group_by"].(string)
+	if groupBy == "" {
+		groupBy = "type"
+	}
+	if !slices.Contains(types.ReleaseNotesGroups, groupBy) {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "release_notes", "group_by must be one of "+strings.Join(types.ReleaseNotesGroups, ", ")))
+	}
+
+	from, _ := args["from_tag"].(string)
+	to, _ := args["to_tag"].(string)
+	tag, _ := args["tag"].(string)
+	notes := &types.ReleaseNotes{GroupBy: groupBy}
+	var target string
+	switch {
+	case from != "":
+		target = to
+		if target == "" {
+			r, _, err := impl.Client.GetRepo(owner, repo)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get repository: %w", err)
+			}
+			target = r.DefaultBranch
+		}
+		notes.From, notes.To = from, target
+		if notes.Pulls, notes.Commits, notes.Truncated, err = rangeChanges(impl.Client, owner, repo, from, target); err != nil {
+			return nil, nil, err
+		}
+		tag = cmp.Or(tag, to)
+	case args["milestone"] != nil:
+		id, ok, err := resolveMilestoneID(impl.Client, owner, repo, args["milestone"])
+		if err != nil {
+			return nil, nil, resolveError(ActionCreate, "release_notes", err)
+		}
+		if !ok {
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "release_notes", "milestone is required"))
+		}
+		milestone, _, err := impl.Client.GetMilestone(owner, repo, id)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get milestone: %w", err)
+		}
+		notes.Milestone = milestone.Title
+		if notes.Pulls, notes.Truncated, err = milestonePulls(impl.Client, owner, repo, id); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "release_notes", "either from_tag or milestone is required"))
+	}
+
+	text := fmt.Sprintf("Release notes for %s/%s: %d pull requests, %d other commits\n\n", owner, repo, len(notes.Pulls), len(notes.Commits))
+	body := notes.ToMarkdown()
+	if publish, _ := args["publish"].(bool); !publish {
+		return textResult(text + body + "\n*Not published. Call again with publish=true to write them to the release, or pass them as the body of create:release or edit:release.*\n"), nil, nil
+	}
+
+	if tag == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "release_notes", "tag is required to publish without to_tag"))
+	}
+	release, resp, err := impl.Client.GetReleaseByTag(owner, repo, tag)
+	switch {
+	case err == nil:
+		release, _, err = impl.Client.EditRelease(owner, repo, release.ID, forgejo.EditReleaseOption{Note: body})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to edit release: %w", err)
+		}
+		text += fmt.Sprintf("Updated the notes of release %s (ID: %d)\n\n", release.TagName, release.ID)
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		draft, _ := args["draft"].(bool)
+		release, _, err = impl.Client.CreateRelease(owner, repo, forgejo.CreateReleaseOption{
+			TagName: tag,
+			Target:  target,
+			Title:   tag,
+			Note:    body,
+			IsDraft: draft,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create release: %w", err)
+		}
+		text += fmt.Sprintf("Created release %s (ID: %d)\n\n", release.TagName, release.ID)
+	default:
+		return nil, nil, fmt.Errorf("failed to get release: %w", err)
+	}
+	return textResult(text + body), nil, nil
+}
+
+// rangeChanges returns the pull requests merged between two refs and the
+// commits of the range not merged through one of them. A pull request is in
+// the range when its merge commit is. truncated is set when the pull requests
+// were cut off at maxReleaseNotesPulls.
+func rangeChanges(client *tools.Client, owner, repo, from, to string) (pulls []*forgejo.PullRequest, others []*forgejo.Commit, truncated bool, err error) {
+	diff, _, err := client.CompareCommits(owner, repo, from, to)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to compare %s...%s: %w", from, to, err)
+	}
+	inRange := map[string]bool{}
+	for _, c := range diff.Commits {
+		inRange[c.SHA] = true
+	}
+	tag, _, err := client.GetTag(owner, repo, from)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to get tag %s: %w", from, err)
+	}
+	var since time.Time
+	if tag.Commit != nil {
+		since = tag.Commit.Created
+	}
+
+	// closed pull requests by last update: any merged after the tag was
+	// updated after it too
+	opt := types.MyPullRequestListOption{State: "closed", Sort: "recentupdate", Limit: listPageSize}
+	for opt.Page = 1; ; opt.Page++ {
+		page, err := client.MyListPullRequests(owner, repo, opt)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to list pull requests: %w", err)
+		}
+		for _, pr := range page {
+			if pr.HasMerged && pr.MergedCommitID != nil && inRange[*pr.MergedCommitID] {
+				pulls = append(pulls, pr)
+			}
+		}
+		if len(page) < listPageSize || (page[len(page)-1].Updated != nil && page[len(page)-1].Updated.Before(since)) {
+			break
+		}
+		if len(pulls) >= maxReleaseNotesPulls {
+			truncated = true
+			break
+		}
+	}
+	sortByMerge(pulls)
+
+	merged := map[string]bool{}
+	for _, pr := range pulls {
+		merged[*pr.MergedCommitID] = true
+		commits, err := listAllPullCommits(client, owner, repo, pr.Index)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("failed to list commits of pull request #%d: %w", pr.Index, err)
+		}
+		for _, c := range commits {
+			merged[c.SHA] = true
+			// rebased commits get new hashes but keep their author and message
+			merged[commitKey(c)] = true
+		}
+	}
+	for _, c := range diff.Commits {
+		if len(c.Parents) > 1 || merged[c.SHA] || merged[commitKey(c)] {
+			continue
+		}
+		others = append(others, c)
+	}
+	return pulls, others, truncated, nil
+}
+
+// commitKey identifies a commit by author and message across rebases.
+func commitKey(c *forgejo.Commit) string {
+	if c.RepoCommit == nil {
+		return c.SHA
+	}
+	return types.CommitAuthor(c) + "\n" + c.RepoCommit.Message
+}
+
+// milestonePulls returns the merged pull requests of a milestone. truncated
+// is set when they were cut off at maxReleaseNotesPulls.
+func milestonePulls(client *tools.Client, owner, repo string, milestone int64) (pulls []*forgejo.PullRequest, truncated bool, err error) {
+	opt := types.MyPullRequestListOption{State: "closed", Milestone: milestone, Limit: listPageSize}
+	for opt.Page = 1; ; opt.Page++ {
+		page, err := client.MyListPullRequests(owner, repo, opt)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list pull requests: %w", err)
+		}
+		for _, pr := range page {
+			if pr.HasMerged {
+				pulls = append(pulls, pr)
+			}
+		}
+		if len(page) < listPageSize {
+			break
+		}
+		if len(pulls) >= maxReleaseNotesPulls {
+			truncated = true
+			break
+		}
+	}
+	sortByMerge(pulls)
+	return pulls, truncated, nil
+}
+
+func sortByMerge(pulls []*forgejo.PullRequest) {
+	slices.SortFunc(pulls, func(a, b *forgejo.PullRequest) int {
+		if a.Merged == nil || b.Merged == nil {
+			return cmp.Compare(a.Index, b.Index)
+		}
+		return a.Merged.Compare(*b.Merged)
+	})
+}
+
+// listAllPullCommits loads every commit of a pull request.
+func listAllPullCommits(client *tools.Client, owner, repo string, index int64) ([]*forgejo.Commit, error) {
+	var ret []*forgejo.Commit
+	opt := forgejo.ListPullRequestCommitsOptions{ListOptions: forgejo.ListOptions{PageSize: listPageSize}}
+	for opt.Page = 1; ; opt.Page++ {
+		commits, _, err := client.ListPullRequestCommits(owner, repo, index, opt)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, commits...)
+		if len(commits) < listPageSize {
+			return ret, nil
+		}
+	}
+}
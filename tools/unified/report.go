@@ -0,0 +1,260 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/tools"
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+const (
+	defaultReportDays = 7
+	maxReportDays     = 90
+
+	// reportMaxPages bounds the task history loaded for a report.
+	reportMaxPages = 40
+	// issuesMaxPages bounds the open issues loaded per repository.
+	issuesMaxPages = 20
+)
+
+// getActionReport pages through the task history of the requested window and
+// renders success rates, durations and flaky jobs per workflow and branch.
+func (impl GetImpl) getActionReport(ctx context.Context, args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "action_report", err.Error()))
+	}
+
+	days := defaultReportDays
+	if n, ok := args["days"].(float64); ok && n > 0 {
+		days = min(int(n), maxReportDays)
+	}
+	workflow, _ := args["workflow"].(string)
+	branch, _ := args["branch"].(string)
+
+	until := time.Now()
+	since := until.AddDate(0, 0, -days)
+
+	var (
+		tasks    []*types.MyActionTask
+		complete bool
+	)
+	for page := 1; page <= reportMaxPages; page++ {
+		response, err := impl.Client.MyListActionTasksContext(ctx, owner, repo, page, taskPageSize)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list action tasks: %w", err)
+		}
+		for _, t := range response.WorkflowRuns {
+			if workflow != "" && t.WorkflowID != workflow {
+				continue
+			}
+			if branch != "" && t.HeadBranch != branch {
+				continue
+			}
+			tasks = append(tasks, t)
+		}
+
+		runs := response.WorkflowRuns
+		if len(runs) < taskPageSize || runs[len(runs)-1].CreatedAt.Before(since) {
+			complete = true
+			break
+		}
+	}
+
+	report := types.BuildCIReport(tasks, since, until)
+	text := report.ToMarkdown()
+	if !complete {
+		text += fmt.Sprintf("\n*Only the newest %d tasks were analyzed, older runs in the window are missing.*\n", reportMaxPages*taskPageSize)
+	}
+	return textResult(text), nil, nil
+}
+
+// getTimeReport aggregates tracked time of one or more repositories over a
+// date range.
+func (impl GetImpl) getTimeReport(args map[string]any) (*mcp.CallToolResult, any, error) {
+	repos, err := reportRepos(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "time_report", err.Error()))
+	}
+
+	opt, err := trackedTimeOptions(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "time_report", err.Error()))
+	}
+	groupBy, _ := args["group_by"].(string)
+	if groupBy != "" && !slices.Contains(types.TimeReportGroups, groupBy) {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "time_report", "group_by must be one of "+strings.Join(types.TimeReportGroups, ", ")))
+	}
+
+	byRepo := map[string][]*forgejo.TrackedTime{}
+	var truncated []string
+	for _, fullName := range repos {
+		owner, repo, _ := strings.Cut(fullName, "/")
+		times, complete, err := impl.listAllRepoTrackedTimes(owner, repo, opt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list tracked times of %s: %w", fullName, err)
+		}
+		byRepo[fullName] = times
+		if !complete {
+			truncated = append(truncated, fullName)
+		}
+	}
+
+	text := types.BuildTimeReport(byRepo, groupBy, opt.Since, opt.Before).ToMarkdown()
+	if len(truncated) > 0 {
+		text += fmt.Sprintf("\n*Only the newest %d entries were counted for %s.*\n", timesPageSize*timesMaxPages, strings.Join(truncated, ", "))
+	}
+	return textResult(text), nil, nil
+}
+
+// reportRepos returns the repositories a report covers as owner/repo, from
+// the repos argument or from owner and repo.
+func reportRepos(args map[string]any) ([]string, error) {
+	var repos []string
+	if arr, ok := args["repos"].([]any); ok {
+		repos = toStringSlice(arr)
+	}
+	if len(repos) == 0 {
+		owner, repo, err := extractOwnerRepo(args)
+		if err != nil {
+			return nil, errors.New("owner and repo, or repos, are required")
+		}
+		return []string{owner + "/" + repo}, nil
+	}
+	return repos, checkRepoNames(repos)
+}
+
+// checkRepoNames ensures every name has the form owner/repo.
+func checkRepoNames(repos []string) error {
+	for _, fullName := range repos {
+		owner, repo, ok := strings.Cut(fullName, "/")
+		if !ok || owner == "" || repo == "" {
+			return fmt.Errorf("invalid repository '%s', expected owner/repo", fullName)
+		}
+	}
+	return nil
+}
+
+// loadDeadlineReport lists the open issues of the repositories and selects
+// those overdue or due within days. The second return value names the
+// repositories with more open issues than issuesMaxPages allows loading.
+func loadDeadlineReport(client *tools.Client, repos []string, days int) (*types.DeadlineReport, []string, error) {
+	byRepo := map[string][]*forgejo.Issue{}
+	var truncated []string
+	for _, fullName := range repos {
+		owner, repo, _ := strings.Cut(fullName, "/")
+		opt := forgejo.ListIssueOption{
+			ListOptions: forgejo.ListOptions{PageSize: listPageSize},
+			State:       forgejo.StateOpen,
+			Type:        forgejo.IssueTypeIssue,
+		}
+		complete := false
+		for opt.Page = 1; opt.Page <= issuesMaxPages; opt.Page++ {
+			issues, _, err := client.ListRepoIssues(owner, repo, opt)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list issues of %s: %w", fullName, err)
+			}
+			byRepo[fullName] = append(byRepo[fullName], issues...)
+			if len(issues) < listPageSize {
+				complete = true
+				break
+			}
+		}
+		if !complete {
+			truncated = append(truncated, fullName)
+		}
+	}
+	return types.BuildDeadlineReport(byRepo, time.Now(), days), truncated, nil
+}
+
+// getDeadlineReport lists overdue and due-soon issues per assignee and
+// milestone across one or more repositories.
+func (impl GetImpl) getDeadlineReport(args map[string]any) (*mcp.CallToolResult, any, error) {
+	repos, err := reportRepos(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "deadline_report", err.Error()))
+	}
+	days := defaultReportDays
+	if n, ok := args["days"].(float64); ok && n >= 0 {
+		days = min(int(n), maxReportDays)
+	}
+	var groupBy []string
+	if by, _ := args["group_by"].(string); by != "" {
+		if !slices.Contains(types.DeadlineGroups, by) {
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "deadline_report", "group_by must be 'assignee' or 'milestone'"))
+		}
+		groupBy = []string{by}
+	}
+
+	report, truncated, err := loadDeadlineReport(impl.Client, repos, days)
+	if err != nil {
+		return nil, nil, err
+	}
+	return textResult(deadlineReportText(report, truncated, groupBy...)), nil, nil
+}
+
+func deadlineReportText(report *types.DeadlineReport, truncated []string, groupBy ...string) string {
+	text := report.ToMarkdown(groupBy...)
+	if len(truncated) > 0 {
+		text += fmt.Sprintf("\n*Only the first %d open issues were checked for %s.*\n", listPageSize*issuesMaxPages, strings.Join(truncated, ", "))
+	}
+	return text
+}
+
+// getMilestoneReport loads every issue of a milestone and reports progress,
+// remaining work per label, overdue issues and a burndown chart.
+func (impl GetImpl) getMilestoneReport(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "milestone_report", err.Error()))
+	}
+	name := milestoneArg(args)
+	if name == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "milestone_report", "milestone is required"))
+	}
+
+	milestone, _, err := impl.Client.GetMilestoneByName(owner, repo, name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get milestone: %w", err)
+	}
+
+	opt := forgejo.ListIssueOption{
+		ListOptions: forgejo.ListOptions{PageSize: listPageSize},
+		State:       forgejo.StateAll,
+		Type:        forgejo.IssueTypeIssue,
+		Milestones:  []string{milestone.Title},
+	}
+	var issues []*forgejo.Issue
+	complete := false
+	for opt.Page = 1; opt.Page <= issuesMaxPages; opt.Page++ {
+		page, _, err := impl.Client.ListRepoIssues(owner, repo, opt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list milestone issues: %w", err)
+		}
+		issues = append(issues, page...)
+		if len(page) < listPageSize {
+			complete = true
+			break
+		}
+	}
+
+	text := types.BuildMilestoneReport(milestone, issues, time.Now()).ToMarkdown()
+	if !complete {
+		text += fmt.Sprintf("\n*Only the first %d issues of the milestone were counted.*\n", listPageSize*issuesMaxPages)
+	}
+	return textResult(text), nil, nil
+}
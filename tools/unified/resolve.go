@@ -0,0 +1,308 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raohwork/forgejo-mcp/tools"
+)
+
+const (
+	// nameCacheTTL bounds how long the labels, milestones and assignees of
+	// a repository are reused to resolve names. A name missing from the
+	// cache reloads it at once, so new labels are found without waiting.
+	nameCacheTTL = 5 * time.Minute
+	// maxListedNames bounds the valid names listed by an unknown name error.
+	maxListedNames = 50
+)
+
+// named is an entry of a repository a name resolves to.
+type named struct {
+	ID   int64
+	Name string
+}
+
+type nameCacheKey struct {
+	client      *tools.Client
+	owner, repo string
+}
+
+type nameCacheEntry struct {
+	loaded  time.Time
+	entries []named
+}
+
+// nameCache keeps the entries of one kind per repository.
+type nameCache struct {
+	kind string
+	load func(client *tools.Client, owner, repo string) ([]named, error)
+
+	mu      sync.Mutex
+	entries map[nameCacheKey]nameCacheEntry
+}
+
+func (c *nameCache) get(client *tools.Client, owner, repo string, reload bool) ([]named, error) {
+	key := nameCacheKey{client, owner, repo}
+	c.mu.Lock()
+	cached, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && !reload && time.Since(cached.loaded) < nameCacheTTL {
+		return cached.entries, nil
+	}
+
+	entries, err := c.load(client, owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %ss of %s/%s: %w", c.kind, owner, repo, err)
+	}
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[nameCacheKey]nameCacheEntry{}
+	}
+	// drop expired entries, so the clients of finished sessions in HTTP
+	// mode are not kept forever
+	maps.DeleteFunc(c.entries, func(_ nameCacheKey, e nameCacheEntry) bool {
+		return time.Since(e.loaded) >= nameCacheTTL
+	})
+	c.entries[key] = nameCacheEntry{loaded: time.Now(), entries: entries}
+	c.mu.Unlock()
+	return entries, nil
+}
+
+// resolve maps a name to its entry: exactly, then ignoring case when that
+// is unambiguous, then as the numeric ID of an entry. The cache is reloaded
+// once before a name is reported unknown.
+func (c *nameCache) resolve(client *tools.Client, owner, repo, name string) (named, error) {
+	var entries []named
+	for _, reload := range []bool{false, true} {
+		var err error
+		if entries, err = c.get(client, owner, repo, reload); err != nil {
+			return named{}, err
+		}
+		if e, ok := matchName(entries, name); ok {
+			return e, nil
+		}
+	}
+	return named{}, &unknownNameError{kind: c.kind, name: name, repo: owner + "/" + repo, valid: entries}
+}
+
+func matchName(entries []named, name string) (named, bool) {
+	var folded []named
+	for _, e := range entries {
+		if e.Name == name {
+			return e, true
+		}
+		if strings.EqualFold(e.Name, name) {
+			folded = append(folded, e)
+		}
+	}
+	if len(folded) == 1 {
+		return folded[0], true
+	}
+	if id, err := strconv.ParseInt(name, 10, 64); err == nil {
+		for _, e := range entries {
+			if e.ID == id {
+				return e, true
+			}
+		}
+	}
+	return named{}, false
+}
+
+// unknownNameError reports a name no entry of the repository has, with the
+// names that would be accepted.
+type unknownNameError struct {
+	kind  string
+	name  string
+	repo  string
+	valid []named
+}
+
+func (e *unknownNameError) Error() string {
+	if len(e.valid) == 0 {
+		return fmt.Sprintf("unknown %s '%s': %s has no %ss", e.kind, e.name, e.repo, e.kind)
+	}
+	var names []string
+	for _, v := range e.valid[:min(len(e.valid), maxListedNames)] {
+		names = append(names, v.Name)
+	}
+	msg := fmt.Sprintf("unknown %s '%s'; valid %ss of %s: %s", e.kind, e.name, e.kind, e.repo, strings.Join(names, ", "))
+	if more := len(e.valid) - len(names); more > 0 {
+		msg += fmt.Sprintf(" and %d more", more)
+	}
+	return msg
+}
+
+// resolveError turns a failed lookup into the error of a handler: unknown
+// names are reported along with the manual of the operation.
+func resolveError(action Action, resource string, err error) error {
+	if unknown := (*unknownNameError)(nil); errors.As(err, &unknown) {
+		return fmt.Errorf("%s", FormatValidationError(action, resource, err.Error()))
+	}
+	return err
+}
+
+var (
+	// labelNameCache holds the labels an issue of a repository can carry,
+	// including those of its organization.
+	labelNameCache = &nameCache{kind: "label", load: func(client *tools.Client, owner, repo string) ([]named, error) {
+		labels, err := listAllLabels(client, tools.RepoLabelScope(owner, repo))
+		if err != nil {
+			return nil, err
+		}
+		// the owner is not necessarily an organization
+		if orgLabels, err := listAllLabels(client, tools.OrgLabelScope(owner)); err == nil {
+			labels = append(labels, orgLabels...)
+		}
+		ret := make([]named, 0, len(labels))
+		for _, l := range labels {
+			if !l.IsArchived {
+				ret = append(ret, named{ID: l.ID, Name: l.Name})
+			}
+		}
+		return ret, nil
+	}}
+
+	milestoneNameCache = &nameCache{kind: "milestone", load: func(client *tools.Client, owner, repo string) ([]named, error) {
+		milestones, err := listAllRepoMilestones(client, owner, repo)
+		if err != nil {
+			return nil, err
+		}
+		ret := make([]named, 0, len(milestones))
+		for _, m := range milestones {
+			ret = append(ret, named{ID: m.ID, Name: m.Title})
+		}
+		return ret, nil
+	}}
+
+	// assigneeNameCache holds the users who can be assigned to issues of a
+	// repository.
+	assigneeNameCache = &nameCache{kind: "assignee", load: func(client *tools.Client, owner, repo string) ([]named, error) {
+		users, _, err := client.GetAssignees(owner, repo)
+		if err != nil {
+			return nil, err
+		}
+		ret := make([]named, 0, len(users))
+		for _, u := range users {
+			ret = append(ret, named{ID: u.ID, Name: u.UserName})
+		}
+		return ret, nil
+	}}
+)
+
+// resolveLabelIDs accepts labels as IDs or names.
+func resolveLabelIDs(client *tools.Client, owner, repo string, values []any) ([]int64, error) {
+	ids := make([]int64, 0, len(values))
+	for _, v := range values {
+		if id, ok := v.(float64); ok {
+			ids = append(ids, int64(id))
+			continue
+		}
+		l, err := labelNameCache.resolve(client, owner, repo, fmt.Sprint(v))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, l.ID)
+	}
+	return ids, nil
+}
+
+// labelIDsByName maps label names, such as those of an issue in another
+// repository or of a template, to the labels an issue of the repository can
+// carry, organization labels included. Only exact names match; names without
+// a label are returned as missing.
+func labelIDsByName(client *tools.Client, owner, repo string, names []string) (ids []int64, missing []string, err error) {
+	for _, reload := range []bool{false, true} {
+		entries, err := labelNameCache.get(client, owner, repo, reload)
+		if err != nil {
+			return nil, nil, err
+		}
+		ids, missing = nil, nil
+		for _, name := range names {
+			idx := slices.IndexFunc(entries, func(e named) bool { return e.Name == name })
+			switch {
+			case idx < 0:
+				missing = append(missing, name)
+			case !slices.Contains(ids, entries[idx].ID):
+				ids = append(ids, entries[idx].ID)
+			}
+		}
+		if len(missing) == 0 {
+			break
+		}
+	}
+	return ids, missing, nil
+}
+
+// resolveScopeLabelID accepts a label of a repository or an organization as
+// an ID or a name. Unlike labelNameCache, which serves issues and includes
+// organization labels, only the labels of the scope itself match. ok is
+// false when the argument is missing.
+func resolveScopeLabelID(client *tools.Client, scope tools.LabelScope, where string, value any) (id int64, ok bool, err error) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), v > 0, nil
+	case string:
+		if v == "" {
+			return 0, false, nil
+		}
+		labels, err := listAllLabels(client, scope)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to list labels of %s: %w", where, err)
+		}
+		entries := make([]named, 0, len(labels))
+		for _, l := range labels {
+			entries = append(entries, named{ID: l.ID, Name: l.Name})
+		}
+		if e, ok := matchName(entries, v); ok {
+			return e.ID, true, nil
+		}
+		return 0, false, &unknownNameError{kind: "label", name: v, repo: where, valid: entries}
+	}
+	return 0, false, nil
+}
+
+// resolveMilestoneID accepts a milestone as an ID or a title. ok is false
+// when the argument is missing.
+func resolveMilestoneID(client *tools.Client, owner, repo string, value any) (id int64, ok bool, err error) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), true, nil
+	case string:
+		if v == "" {
+			return 0, false, nil
+		}
+		m, err := milestoneNameCache.resolve(client, owner, repo, v)
+		return m.ID, err == nil, err
+	}
+	return 0, false, nil
+}
+
+// resolveAssignees accepts users as usernames or user IDs and returns their
+// usernames, checked against the users who can be assigned.
+func resolveAssignees(client *tools.Client, owner, repo string, values []any) ([]string, error) {
+	names := make([]string, 0, len(values))
+	for _, v := range values {
+		name := fmt.Sprint(v)
+		if id, ok := v.(float64); ok {
+			name = strconv.FormatInt(int64(id), 10)
+		}
+		u, err := assigneeNameCache.resolve(client, owner, repo, name)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, u.Name)
+	}
+	return names, nil
+}
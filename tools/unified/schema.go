@@ -6,7 +6,11 @@
 
 package unified
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
 
 // Action represents the type of operation being performed.
 type Action string
@@ -26,16 +30,40 @@ type Resource string
 
 const (
 	ResourceIssue             Resource = "issue"
+	ResourceIssueBulk         Resource = "issue_bulk"
+	ResourceIssueMove         Resource = "issue_move"
+	ResourceIssueSearch       Resource = "issue_search"
 	ResourceIssueComment      Resource = "issue_comment"
+	ResourceIssueTimeline     Resource = "issue_timeline"
+	ResourceIssueTemplate     Resource = "issue_template"
+	ResourceIssueSubscription Resource = "issue_subscription"
+	ResourceRepoWatch         Resource = "repo_watch"
+	ResourceDeadlineReport    Resource = "deadline_report"
+	ResourceMilestoneReport   Resource = "milestone_report"
+	ResourceIssueGraph        Resource = "issue_graph"
+	ResourceReaction          Resource = "reaction"
+	ResourceTrackedTime       Resource = "tracked_time"
+	ResourceStopwatch         Resource = "stopwatch"
+	ResourceTimeReport        Resource = "time_report"
 	ResourceIssueAttachment   Resource = "issue_attachment"
 	ResourceLabel             Resource = "label"
+	ResourceLabelSync         Resource = "label_sync"
 	ResourceMilestone         Resource = "milestone"
+	ResourceMilestoneSync     Resource = "milestone_sync"
 	ResourceRelease           Resource = "release"
+	ResourceReleaseNotes      Resource = "release_notes"
 	ResourceReleaseAttachment Resource = "release_attachment"
 	ResourceWikiPage          Resource = "wiki_page"
 	ResourcePullRequest       Resource = "pull_request"
 	ResourceRepository        Resource = "repository"
 	ResourceActionTask        Resource = "action_task"
+	ResourceActionRun         Resource = "action_run"
+	ResourceActionReport      Resource = "action_report"
+	ResourceActionWorkflow    Resource = "action_workflow"
+	ResourceActionArtifact    Resource = "action_artifact"
+	ResourceActionVariable    Resource = "action_variable"
+	ResourceActionSecret      Resource = "action_secret"
+	ResourceRunnerToken       Resource = "runner_token"
 )
 
 // LinkType represents the type of relationship between resources.
@@ -81,6 +109,71 @@ func commonRepoParams() []ParamSpec {
 	}
 }
 
+// issueLinkParams returns the parameters of a dependency or blocking link,
+// where owner and repo may be left out when index is a full reference.
+func issueLinkParams(index, other ParamSpec) []ParamSpec {
+	return []ParamSpec{
+		{Name: "owner", Type: "string", Required: false, Description: "Repository owner (required unless index is 'owner/repo#123' or a URL)"},
+		{Name: "repo", Type: "string", Required: false, Description: "Repository name (required unless index is 'owner/repo#123' or a URL)"},
+		index,
+		other,
+	}
+}
+
+// labelScopeParams returns the parameters selecting the owner of labels:
+// a repository, or an organization when only org is given.
+func labelScopeParams() []ParamSpec {
+	return []ParamSpec{
+		{Name: "owner", Type: "string", Required: false, Description: "Repository owner (required without org)"},
+		{Name: "repo", Type: "string", Required: false, Description: "Repository name (required without org)"},
+		{Name: "org", Type: "string", Required: false, Description: "Organization, for labels shared by all its repositories"},
+	}
+}
+
+// actionScopeParams returns the parameters selecting the owner of Actions
+// settings (secrets, variables): a repository, an organization or the user.
+func actionScopeParams() []ParamSpec {
+	return []ParamSpec{
+		{Name: "scope", Type: "string", Required: false, Description: "Owner of the setting (default 'repo')", Enum: []string{"repo", "org", "user"}},
+		{Name: "owner", Type: "string", Required: false, Description: "Repository owner (required for scope='repo')"},
+		{Name: "repo", Type: "string", Required: false, Description: "Repository name (required for scope='repo')"},
+		{Name: "org", Type: "string", Required: false, Description: "Organization name (required for scope='org')"},
+	}
+}
+
+// runnerScopeParams returns the parameters selecting whose runners to manage.
+// Unlike other Actions settings, runners may also be shared by the instance.
+func runnerScopeParams() []ParamSpec {
+	params := actionScopeParams()
+	params[0] = ParamSpec{Name: "scope", Type: "string", Required: false, Description: "Owner of the runners (default 'repo', 'instance' requires site admin)", Enum: []string{"repo", "org", "user", "instance"}}
+	return params
+}
+
+// bulkIssueParams returns the parameters selecting the issues of a bulk
+// operation, which replace index.
+func bulkIssueParams() []ParamSpec {
+	return append(commonRepoParams(),
+		ParamSpec{Name: "indexes", Type: "array", Required: false, Description: fmt.Sprintf("Issue numbers to change (at most %d)", bulkMaxIssues)},
+		ParamSpec{Name: "filter", Type: "object", Required: false, Description: "Instead of indexes, change every issue matching these list:issue filters (state, labels, milestones, q, assignees, since, before); pull requests are never matched"},
+		ParamSpec{Name: "concurrency", Type: "integer", Required: false, Description: fmt.Sprintf("Issues changed in parallel (default %d, max %d)", defaultBulkConcurrency, maxBulkConcurrency)},
+	)
+}
+
+// reactionParams returns the parameters selecting the issue or comment a
+// reaction belongs to.
+func reactionParams() []ParamSpec {
+	return append(commonRepoParams(),
+		ParamSpec{Name: "index", Type: "integer", Required: false, Description: "Issue or pull request number (required without comment_id)"},
+		ParamSpec{Name: "comment_id", Type: "integer", Required: false, Description: "Comment ID, to react to a comment instead of the issue"},
+	)
+}
+
+// reactionContentParam documents the reaction name. Forgejo accepts the
+// instance's configured set, DefaultReactions unless customized.
+func reactionContentParam() ParamSpec {
+	return ParamSpec{Name: "content", Type: "string", Required: true, Description: "Reaction name; ':+1:' and emoji like '👍' are accepted too", Enum: types.DefaultReactions}
+}
+
 // Manual is the documentation registry for all action+resource combinations.
 // It provides on-demand documentation lookup and powers rich error messages.
 var Manual = map[string]ManualEntry{
@@ -88,16 +181,30 @@ var Manual = map[string]ManualEntry{
 	"create:issue": {
 		Action:      ActionCreate,
 		Resource:    ResourceIssue,
-		Description: "Create a new issue in a repository.",
+		Description: "Create a new issue in a repository, optionally from one of its issue templates (see list:issue_template). A template applies its title prefix, default labels and assignees; form templates render the body from fields and check required ones.",
 		Params: append(commonRepoParams(),
 			ParamSpec{Name: "title", Type: "string", Required: true, Description: "Issue title"},
-			ParamSpec{Name: "body", Type: "string", Required: true, Description: "Issue body (markdown)"},
-			ParamSpec{Name: "assignees", Type: "array", Required: false, Description: "Usernames to assign"},
-			ParamSpec{Name: "milestone", Type: "integer", Required: false, Description: "Milestone ID"},
-			ParamSpec{Name: "labels", Type: "array", Required: false, Description: "Label IDs to attach"},
+			ParamSpec{Name: "body", Type: "string", Required: false, Description: "Issue body (markdown); required without template, appended to form fields"},
+			ParamSpec{Name: "template", Type: "string", Required: false, Description: "Issue template name or file name"},
+			ParamSpec{Name: "fields", Type: "object", Required: false, Description: "Form field values by field ID; lists of strings for checkboxes and multiple choice dropdowns"},
+			ParamSpec{Name: "assignees", Type: "array", Required: false, Description: "Usernames (or user IDs) to assign"},
+			ParamSpec{Name: "milestone", Type: "integer|string", Required: false, Description: "Milestone title or ID"},
+			ParamSpec{Name: "labels", Type: "array", Required: false, Description: "Label names or IDs to attach"},
 			ParamSpec{Name: "due_date", Type: "string", Required: false, Description: "Due date (RFC3339 format)"},
 		),
-		Example: `create_gitea(resource="issue", owner="org", repo="project", title="Bug report", body="Description...")`,
+		Example: `create_gitea(resource="issue", owner="org", repo="project", title="Login fails", template="bug", fields={"what": "Error 500 on login", "terms": ["I agree"]})`,
+	},
+	"create:issue_move": {
+		Action:      ActionCreate,
+		Resource:    ResourceIssueMove,
+		Description: "Move an issue to another repository. The issue is recreated in the target with its title, body, labels (matched by name, organization labels included), milestone (matched by title), assignees (those not assignable in the target are dropped) and comments quoting their original authors and times. Both issues are cross-linked and the original is closed.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue number in the source repository"},
+			ParamSpec{Name: "target_owner", Type: "string", Required: false, Description: "Owner of the target repository (default same as owner)"},
+			ParamSpec{Name: "target_repo", Type: "string", Required: true, Description: "Target repository name"},
+			ParamSpec{Name: "keep_open", Type: "boolean", Required: false, Description: "Leave the original issue open"},
+		),
+		Example: `create_gitea(resource="issue_move", owner="org", repo="monorepo", index=42, target_repo="backend")`,
 	},
 	"create:issue_comment": {
 		Action:      ActionCreate,
@@ -109,16 +216,55 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `create_gitea(resource="issue_comment", owner="org", repo="project", index=42, body="Thanks!")`,
 	},
+	"create:issue_subscription": {
+		Action:      ActionCreate,
+		Resource:    ResourceIssueSubscription,
+		Description: "Subscribe to an issue or pull request to be notified of its updates.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue or pull request number"},
+			ParamSpec{Name: "user", Type: "string", Required: false, Description: "Username to subscribe (default yourself, others require admin rights)"},
+		),
+		Example: `create_gitea(resource="issue_subscription", owner="org", repo="project", index=42)`,
+	},
+	"create:reaction": {
+		Action:      ActionCreate,
+		Resource:    ResourceReaction,
+		Description: "React to an issue, pull request or comment.",
+		Params:      append(reactionParams(), reactionContentParam()),
+		Example:     `create_gitea(resource="reaction", owner="org", repo="project", comment_id=123, content="+1")`,
+	},
+	"create:tracked_time": {
+		Action:      ActionCreate,
+		Resource:    ResourceTrackedTime,
+		Description: "Log time spent on an issue or pull request.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue or pull request number"},
+			ParamSpec{Name: "duration", Type: "string", Required: true, Description: "Time spent, as seconds or a duration like '1h30m'"},
+			ParamSpec{Name: "date", Type: "string", Required: false, Description: "When the work was done (YYYY-MM-DD or RFC3339, default now)"},
+			ParamSpec{Name: "user", Type: "string", Required: false, Description: "Log time for another user (repository admins only)"},
+		),
+		Example: `create_gitea(resource="tracked_time", owner="org", repo="project", index=42, duration="1h30m", date="2024-01-15")`,
+	},
+	"create:stopwatch": {
+		Action:      ActionCreate,
+		Resource:    ResourceStopwatch,
+		Description: "Start the stopwatch on an issue or pull request.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue or pull request number"},
+		),
+		Example: `create_gitea(resource="stopwatch", owner="org", repo="project", index=42)`,
+	},
 	"create:label": {
 		Action:      ActionCreate,
 		Resource:    ResourceLabel,
-		Description: "Create a new label in a repository.",
-		Params: append(commonRepoParams(),
+		Description: "Create a new label in a repository, or in an organization when only org is given.",
+		Params: append(labelScopeParams(),
 			ParamSpec{Name: "name", Type: "string", Required: true, Description: "Label name"},
 			ParamSpec{Name: "color", Type: "string", Required: true, Description: "Hex color (without #, e.g., 'ff0000')"},
 			ParamSpec{Name: "description", Type: "string", Required: false, Description: "Label description"},
+			ParamSpec{Name: "exclusive", Type: "boolean", Required: false, Description: "Scoped label (named 'scope/value') that replaces other exclusive labels of its scope on an issue"},
 		),
-		Example: `create_gitea(resource="label", owner="org", repo="project", name="bug", color="ff0000")`,
+		Example: `create_gitea(resource="label", owner="org", repo="project", name="priority/high", color="ee0701", exclusive=true)`,
 	},
 	"create:milestone": {
 		Action:      ActionCreate,
@@ -146,6 +292,21 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `create_gitea(resource="release", owner="org", repo="project", tag_name="v1.0.0", name="Version 1.0")`,
 	},
+	"create:release_notes": {
+		Action:      ActionCreate,
+		Resource:    ResourceReleaseNotes,
+		Description: "Generate release notes from the pull requests merged between two tags, or merged in a milestone. Between tags, commits not merged through a pull request are listed too. Changes are grouped by conventional-commit type (feat, fix, ..., breaking changes first) or by label, and authors are credited. Without publish the notes are only returned, ready for the body of create:release or edit:release.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "from_tag", Type: "string", Required: false, Description: "Previous release tag (required without milestone)"},
+			ParamSpec{Name: "to_tag", Type: "string", Required: false, Description: "New release tag or branch (default: the default branch)"},
+			ParamSpec{Name: "milestone", Type: "integer|string", Required: false, Description: "Milestone title or ID, instead of tags"},
+			ParamSpec{Name: "group_by", Type: "string", Required: false, Description: "Group changes by conventional-commit type or by label (default type)", Enum: types.ReleaseNotesGroups},
+			ParamSpec{Name: "publish", Type: "boolean", Required: false, Description: "Write the notes to the release of the tag, creating the release when missing (default false)"},
+			ParamSpec{Name: "tag", Type: "string", Required: false, Description: "Tag of the release to publish to (default to_tag; required with milestone)"},
+			ParamSpec{Name: "draft", Type: "boolean", Required: false, Description: "Create the release as a draft when publishing creates it"},
+		),
+		Example: `create_gitea(resource="release_notes", owner="org", repo="project", from_tag="v1.2.0", to_tag="v1.3.0", publish=true)`,
+	},
 	"create:wiki_page": {
 		Action:      ActionCreate,
 		Resource:    ResourceWikiPage,
@@ -166,12 +327,40 @@ var Manual = map[string]ManualEntry{
 			ParamSpec{Name: "body", Type: "string", Required: false, Description: "PR description (markdown)"},
 			ParamSpec{Name: "head", Type: "string", Required: true, Description: "Source branch (or 'user:branch' for forks)"},
 			ParamSpec{Name: "base", Type: "string", Required: true, Description: "Target branch"},
-			ParamSpec{Name: "assignees", Type: "array", Required: false, Description: "Usernames to assign"},
-			ParamSpec{Name: "milestone", Type: "integer", Required: false, Description: "Milestone ID"},
-			ParamSpec{Name: "labels", Type: "array", Required: false, Description: "Label IDs"},
+			ParamSpec{Name: "assignees", Type: "array", Required: false, Description: "Usernames (or user IDs) to assign"},
+			ParamSpec{Name: "milestone", Type: "integer|string", Required: false, Description: "Milestone title or ID"},
+			ParamSpec{Name: "labels", Type: "array", Required: false, Description: "Label names or IDs"},
 		),
 		Example: `create_gitea(resource="pull_request", owner="org", repo="project", title="Feature X", head="feature-x", base="main")`,
 	},
+	"create:repo_watch": {
+		Action:      ActionCreate,
+		Resource:    ResourceRepoWatch,
+		Description: "Watch a repository to be notified of all its issues, pull requests and releases.",
+		Params:      commonRepoParams(),
+		Example:     `create_gitea(resource="repo_watch", owner="org", repo="project")`,
+	},
+	"create:action_variable": {
+		Action:      ActionCreate,
+		Resource:    ResourceActionVariable,
+		Description: "Create a Forgejo Actions variable for a repository, organization or the user.",
+		Params: append(actionScopeParams(),
+			ParamSpec{Name: "name", Type: "string", Required: true, Description: "Variable name"},
+			ParamSpec{Name: "value", Type: "string", Required: true, Description: "Variable value"},
+		),
+		Example: `create_gitea(resource="action_variable", owner="org", repo="project", name="DEPLOY_HOST", value="example.com")`,
+	},
+	"create:action_secret": {
+		Action:      ActionCreate,
+		Resource:    ResourceActionSecret,
+		Description: "Create a Forgejo Actions secret for a repository, organization or the user. Secret values are write-only and can never be read back, so an existing secret is refused: replace it with edit:action_secret. Secrets of the user cannot be listed, so creating one there requires confirm=true.",
+		Params: append(actionScopeParams(),
+			ParamSpec{Name: "name", Type: "string", Required: true, Description: "Secret name"},
+			ParamSpec{Name: "value", Type: "string", Required: true, Description: "Secret value"},
+			ParamSpec{Name: "confirm", Type: "boolean", Required: false, Description: "Required for scope='user', where an existing secret cannot be detected and would be replaced"},
+		),
+		Example: `create_gitea(resource="action_secret", scope="org", org="myorg", name="REGISTRY_TOKEN", value="...")`,
+	},
 
 	// === GET ===
 	"get:issue": {
@@ -183,6 +372,17 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `get_gitea(resource="issue", owner="org", repo="project", index=42)`,
 	},
+	"get:issue_template": {
+		Action:      ActionGet,
+		Resource:    ResourceIssueTemplate,
+		Description: "Show an issue or pull request template: its default title, labels and assignees, the form fields with their options and whether they are required, or the markdown body. Problems in the template file are reported too.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "name", Type: "string", Required: true, Description: "Template name or file name"},
+			ParamSpec{Name: "kind", Type: "string", Required: false, Description: "Only issue or pull request templates", Enum: []string{types.TemplateKindIssue, types.TemplateKindPullRequest}},
+			ParamSpec{Name: "ref", Type: "string", Required: false, Description: "Branch, tag or commit (default branch if omitted)"},
+		),
+		Example: `get_gitea(resource="issue_template", owner="org", repo="project", name="bug")`,
+	},
 	"get:wiki_page": {
 		Action:      ActionGet,
 		Resource:    ResourceWikiPage,
@@ -201,12 +401,141 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `get_gitea(resource="pull_request", owner="org", repo="project", index=42)`,
 	},
+	"get:release": {
+		Action:      ActionGet,
+		Resource:    ResourceRelease,
+		Description: "Get a release by ID, by tag or the latest one (the newest release which is neither a draft nor a prerelease), with its notes and assets including download counts.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "id", Type: "integer", Required: false, Description: "Release ID"},
+			ParamSpec{Name: "tag", Type: "string", Required: false, Description: "Tag name of the release"},
+			ParamSpec{Name: "latest", Type: "boolean", Required: false, Description: "Get the latest release"},
+		),
+		Example: `get_gitea(resource="release", owner="org", repo="project", latest=true)`,
+	},
 	"get:repository": {
 		Action:      ActionGet,
 		Resource:    ResourceRepository,
 		Description: "Get details of a specific repository.",
-		Params: commonRepoParams(),
-		Example: `get_gitea(resource="repository", owner="org", repo="project")`,
+		Params:      commonRepoParams(),
+		Example:     `get_gitea(resource="repository", owner="org", repo="project")`,
+	},
+	"get:action_run": {
+		Action:      ActionGet,
+		Resource:    ResourceActionRun,
+		Description: "Get the job states of a Forgejo Actions run. With wait=true, block until every job finished or the timeout expires, sending MCP progress notifications whenever a job changes state. Only job states are reported, Forgejo does not expose step states through the API.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "run_number", Type: "integer", Required: false, Description: "Run number (default: newest run)"},
+			ParamSpec{Name: "sha", Type: "string", Required: false, Description: "Commit SHA or prefix, watches every run triggered by that commit"},
+			ParamSpec{Name: "wait", Type: "boolean", Required: false, Description: "Wait until the run finished"},
+			ParamSpec{Name: "timeout", Type: "integer", Required: false, Description: "Seconds to wait (default 600, max 3600)"},
+			ParamSpec{Name: "interval", Type: "integer", Required: false, Description: "Seconds between polls (default 15, min 5)"},
+		),
+		Example: `get_gitea(resource="action_run", owner="org", repo="project", sha="a1b2c3d", wait=true, timeout=900)`,
+	},
+	"get:action_report": {
+		Action:      ActionGet,
+		Resource:    ResourceActionReport,
+		Description: "CI analytics over the Actions history of a time window: per workflow and branch success rate, median and p95 run duration, plus jobs that both succeeded and failed on the same commit.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "days", Type: "integer", Required: false, Description: "Size of the window in days, ending now (default 7, max 90)"},
+			ParamSpec{Name: "workflow", Type: "string", Required: false, Description: "Only include this workflow file (e.g. 'ci.yml')"},
+			ParamSpec{Name: "branch", Type: "string", Required: false, Description: "Only include runs on this branch"},
+		),
+		Example: `get_gitea(resource="action_report", owner="org", repo="project", days=7)`,
+	},
+	"get:time_report": {
+		Action:      ActionGet,
+		Resource:    ResourceTimeReport,
+		Description: "Aggregate tracked time over a date range, per user, milestone, repository or issue, with totals and shares.",
+		Params: []ParamSpec{
+			{Name: "owner", Type: "string", Required: false, Description: "Repository owner (required without repos)"},
+			{Name: "repo", Type: "string", Required: false, Description: "Repository name (required without repos)"},
+			{Name: "repos", Type: "array", Required: false, Description: "Several repositories as 'owner/repo'"},
+			{Name: "group_by", Type: "string", Required: false, Description: "Grouping (default 'user')", Enum: types.TimeReportGroups},
+			{Name: "since", Type: "string", Required: false, Description: "Only time logged at or after (YYYY-MM-DD or RFC3339)"},
+			{Name: "before", Type: "string", Required: false, Description: "Only time logged before (YYYY-MM-DD or RFC3339)"},
+			{Name: "user", Type: "string", Required: false, Description: "Only time logged by this user"},
+		},
+		Example: `get_gitea(resource="time_report", repos=["org/api", "org/web"], group_by="milestone", since="2024-01-01", before="2024-02-01")`,
+	},
+	"get:deadline_report": {
+		Action:      ActionGet,
+		Resource:    ResourceDeadlineReport,
+		Description: "List open issues that are overdue or due soon, per assignee and per milestone, with counts and links. Also available as the 'standup' prompt.",
+		Params: []ParamSpec{
+			{Name: "owner", Type: "string", Required: false, Description: "Repository owner (required without repos)"},
+			{Name: "repo", Type: "string", Required: false, Description: "Repository name (required without repos)"},
+			{Name: "repos", Type: "array", Required: false, Description: "Several repositories as 'owner/repo'"},
+			{Name: "days", Type: "integer", Required: false, Description: fmt.Sprintf("Days ahead counted as due soon (default %d, max %d)", defaultReportDays, maxReportDays)},
+			{Name: "group_by", Type: "string", Required: false, Description: "Only one grouping (default both)", Enum: types.DeadlineGroups},
+		},
+		Example: `get_gitea(resource="deadline_report", repos=["org/api", "org/web"], days=7)`,
+	},
+	"get:milestone_report": {
+		Action:      ActionGet,
+		Resource:    ResourceMilestoneReport,
+		Description: fmt.Sprintf("Answer whether a milestone is on track: percent complete, a projected finish date at the pace of the last %d days, remaining issues by label, overdue issues and a daily burndown rendered as a Mermaid chart. The burndown counts issues from their creation date.", types.VelocityDays),
+		Params: []ParamSpec{
+			{Name: "owner", Type: "string", Required: true, Description: "Repository owner"},
+			{Name: "repo", Type: "string", Required: true, Description: "Repository name"},
+			{Name: "milestone", Type: "string", Required: true, Description: "Milestone title or ID"},
+		},
+		Example: `get_gitea(resource="milestone_report", owner="org", repo="api", milestone="v2.3")`,
+	},
+	"get:issue_graph": {
+		Action:      ActionGet,
+		Resource:    ResourceIssueGraph,
+		Description: "Walk issue dependencies transitively, across repositories, from one issue or every issue of a milestone. Renders a Mermaid flowchart, reports dependency cycle groups with the links inside them and lists the open issues that can start now followed by the order of the rest.",
+		Params: []ParamSpec{
+			{Name: "owner", Type: "string", Required: true, Description: "Repository owner"},
+			{Name: "repo", Type: "string", Required: true, Description: "Repository name"},
+			{Name: "index", Type: "integer", Required: false, Description: "Root issue number (required without milestone)"},
+			{Name: "milestone", Type: "string", Required: false, Description: "Milestone title or ID whose issues are the roots (required without index)"},
+			{Name: "direction", Type: "string", Required: false, Description: "Links to follow: what the roots depend on, what they block, or both (default 'both')", Enum: graphDirections},
+			{Name: "max_issues", Type: "integer", Required: false, Description: fmt.Sprintf("Stop adding issues after this many (default %d, max %d)", defaultGraphIssues, maxGraphIssues)},
+		},
+		Example: `get_gitea(resource="issue_graph", owner="org", repo="api", milestone="v2.0")`,
+	},
+	"get:action_workflow": {
+		Action:      ActionGet,
+		Resource:    ResourceActionWorkflow,
+		Description: "Parse and validate a workflow file, reporting triggers, jobs, runs-on labels and schema errors with line numbers. Pass content to check an edit before committing it; validation runs locally.",
+		Params: []ParamSpec{
+			{Name: "owner", Type: "string", Required: false, Description: "Repository owner (required without content)"},
+			{Name: "repo", Type: "string", Required: false, Description: "Repository name (required without content)"},
+			{Name: "path", Type: "string", Required: false, Description: "Workflow file path, a bare file name is looked up in .forgejo/workflows"},
+			{Name: "ref", Type: "string", Required: false, Description: "Branch, tag or commit (default: default branch)"},
+			{Name: "content", Type: "string", Required: false, Description: "Workflow YAML to validate instead of a file in the repository"},
+		},
+		Example: `get_gitea(resource="action_workflow", path="ci.yml", content="on: push\njobs: ...")`,
+	},
+	"get:action_artifact": {
+		Action:      ActionGet,
+		Resource:    ResourceActionArtifact,
+		Description: "Download a Forgejo Actions artifact and inspect its files. Without 'entries' the files in the zip are listed; with 'entries' the matching text files (e.g. JUnit XML, coverage summaries) are returned within the byte budget. Needs a server with the artifacts API (Gitea 1.23+; not Forgejo 11).",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "id", Type: "integer", Required: true, Description: "Artifact ID"},
+			ParamSpec{Name: "entries", Type: "array", Required: false, Description: "File paths or glob patterns (e.g. '*.xml') to read from the zip"},
+			ParamSpec{Name: "max_bytes", Type: "integer", Required: false, Description: "Total byte budget for file contents (default 65536)"},
+			ParamSpec{Name: "include_zip", Type: "boolean", Required: false, Description: "Also return the whole zip as an embedded resource"},
+		),
+		Example: `get_gitea(resource="action_artifact", owner="org", repo="project", id=12, entries=["*.xml"])`,
+	},
+	"get:action_variable": {
+		Action:      ActionGet,
+		Resource:    ResourceActionVariable,
+		Description: "Get a Forgejo Actions variable and its value.",
+		Params: append(actionScopeParams(),
+			ParamSpec{Name: "name", Type: "string", Required: true, Description: "Variable name"},
+		),
+		Example: `get_gitea(resource="action_variable", owner="org", repo="project", name="DEPLOY_HOST")`,
+	},
+	"get:runner_token": {
+		Action:      ActionGet,
+		Resource:    ResourceRunnerToken,
+		Description: "Get the registration token used to register new Forgejo Actions runners. Requires admin rights on the scope.",
+		Params:      runnerScopeParams(),
+		Example:     `get_gitea(resource="runner_token", scope="org", org="myorg")`,
 	},
 
 	// === LIST ===
@@ -229,6 +558,28 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `list_gitea(resource="issue", owner="org", repo="project", state="open", labels="bug")`,
 	},
+	"list:issue_search": {
+		Action:      ActionList,
+		Resource:    ResourceIssueSearch,
+		Description: "Search issues and pull requests across every repository you can access, e.g. everything assigned to you. Results carry owner/repo#index references.",
+		Params: []ParamSpec{
+			{Name: "type", Type: "string", Required: false, Description: "Only issues or only pull requests (default both)", Enum: []string{"issues", "pulls"}},
+			{Name: "state", Type: "string", Required: false, Description: "Filter by state (default open)", Enum: []string{"open", "closed", "all"}},
+			{Name: "labels", Type: "string", Required: false, Description: "Comma-separated label names"},
+			{Name: "milestones", Type: "string", Required: false, Description: "Comma-separated milestone names"},
+			{Name: "q", Type: "string", Required: false, Description: "Search keyword"},
+			{Name: "assigned", Type: "boolean", Required: false, Description: "Only those assigned to you"},
+			{Name: "created", Type: "boolean", Required: false, Description: "Only those created by you"},
+			{Name: "mentioned", Type: "boolean", Required: false, Description: "Only those mentioning you"},
+			{Name: "review_requested", Type: "boolean", Required: false, Description: "Only pull requests awaiting your review"},
+			{Name: "owner", Type: "string", Required: false, Description: "Only repositories of this user or organization"},
+			{Name: "team", Type: "string", Required: false, Description: "Only repositories of this team (requires owner)"},
+			{Name: "since", Type: "string", Required: false, Description: "Only updated after (RFC3339)"},
+			{Name: "page", Type: "integer", Required: false, Description: "Page number"},
+			{Name: "limit", Type: "integer", Required: false, Description: "Results per page"},
+		},
+		Example: `list_gitea(resource="issue_search", assigned=true, state="open")`,
+	},
 	"list:issue_comment": {
 		Action:      ActionList,
 		Resource:    ResourceIssueComment,
@@ -240,6 +591,67 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `list_gitea(resource="issue_comment", owner="org", repo="project", index=42)`,
 	},
+	"list:issue_subscription": {
+		Action:      ActionList,
+		Resource:    ResourceIssueSubscription,
+		Description: "List the users subscribed to an issue or pull request, and whether you are.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue or pull request number"},
+		),
+		Example: `list_gitea(resource="issue_subscription", owner="org", repo="project", index=42)`,
+	},
+	"list:issue_timeline": {
+		Action:      ActionList,
+		Resource:    ResourceIssueTimeline,
+		Description: "List the full event history of an issue or pull request in chronological order: comments, label changes, assignments, milestone moves, closing and reopening, references from commits and other issues, title edits and reviews. Use it to find out who closed an issue and why.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue or pull request number"},
+			ParamSpec{Name: "since", Type: "string", Required: false, Description: "Only events after (YYYY-MM-DD or RFC3339)"},
+			ParamSpec{Name: "before", Type: "string", Required: false, Description: "Only events before (YYYY-MM-DD or RFC3339)"},
+			ParamSpec{Name: "page", Type: "integer", Required: false, Description: "Page number (default loads the whole timeline)"},
+			ParamSpec{Name: "limit", Type: "integer", Required: false, Description: "Events per page, used with page"},
+		),
+		Example: `list_gitea(resource="issue_timeline", owner="org", repo="project", index=42)`,
+	},
+	"list:issue_template": {
+		Action:      ActionList,
+		Resource:    ResourceIssueTemplate,
+		Description: "List the issue and pull request templates of a repository, read from the ISSUE_TEMPLATE directories and template files in .forgejo, .gitea and .github (markdown and YAML forms).",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "kind", Type: "string", Required: false, Description: "Only issue or pull request templates", Enum: []string{types.TemplateKindIssue, types.TemplateKindPullRequest}},
+			ParamSpec{Name: "ref", Type: "string", Required: false, Description: "Branch, tag or commit (default branch if omitted)"},
+		),
+		Example: `list_gitea(resource="issue_template", owner="org", repo="project")`,
+	},
+	"list:reaction": {
+		Action:      ActionList,
+		Resource:    ResourceReaction,
+		Description: "List reactions on an issue, pull request or comment, grouped and counted by type.",
+		Params:      reactionParams(),
+		Example:     `list_gitea(resource="reaction", owner="org", repo="project", index=42)`,
+	},
+	"list:tracked_time": {
+		Action:      ActionList,
+		Resource:    ResourceTrackedTime,
+		Description: "List tracked time entries of an issue, or of the whole repository when index is omitted.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: false, Description: "Issue or pull request number"},
+			ParamSpec{Name: "user", Type: "string", Required: false, Description: "Only entries of this user (repository listing only)"},
+			ParamSpec{Name: "since", Type: "string", Required: false, Description: "Only entries at or after (YYYY-MM-DD or RFC3339)"},
+			ParamSpec{Name: "before", Type: "string", Required: false, Description: "Only entries before (YYYY-MM-DD or RFC3339)"},
+			ParamSpec{Name: "page", Type: "integer", Required: false, Description: "Page number"},
+			ParamSpec{Name: "limit", Type: "integer", Required: false, Description: "Results per page"},
+		),
+		Example: `list_gitea(resource="tracked_time", owner="org", repo="project", index=42)`,
+	},
+	"list:stopwatch": {
+		Action:      ActionList,
+		Resource:    ResourceStopwatch,
+		Description: "List your running stopwatches.",
+		Params:      []ParamSpec{},
+		Example:     `list_gitea(resource="stopwatch")`,
+	},
+
 	"list:issue_attachment": {
 		Action:      ActionList,
 		Resource:    ResourceIssueAttachment,
@@ -252,9 +664,9 @@ var Manual = map[string]ManualEntry{
 	"list:label": {
 		Action:      ActionList,
 		Resource:    ResourceLabel,
-		Description: "List all labels in a repository.",
-		Params:      commonRepoParams(),
-		Example:     `list_gitea(resource="label", owner="org", repo="project")`,
+		Description: "List all labels in a repository, or of an organization when only org is given. Shows IDs and the exclusive flag.",
+		Params:      labelScopeParams(),
+		Example:     `list_gitea(resource="label", org="org")`,
 	},
 	"list:milestone": {
 		Action:      ActionList,
@@ -301,8 +713,8 @@ var Manual = map[string]ManualEntry{
 		Params: append(commonRepoParams(),
 			ParamSpec{Name: "state", Type: "string", Required: false, Description: "Filter by state", Enum: []string{"open", "closed", "all"}},
 			ParamSpec{Name: "sort", Type: "string", Required: false, Description: "Sort field", Enum: []string{"oldest", "recentupdate", "leastupdate", "mostcomment", "leastcomment", "priority"}},
-			ParamSpec{Name: "milestone", Type: "integer", Required: false, Description: "Milestone ID"},
-			ParamSpec{Name: "labels", Type: "array", Required: false, Description: "Label IDs"},
+			ParamSpec{Name: "milestone", Type: "integer|string", Required: false, Description: "Milestone title or ID"},
+			ParamSpec{Name: "labels", Type: "array", Required: false, Description: "Label names or IDs; pull requests carrying all of them"},
 			ParamSpec{Name: "page", Type: "integer", Required: false, Description: "Page number"},
 			ParamSpec{Name: "limit", Type: "integer", Required: false, Description: "Results per page"},
 		),
@@ -326,6 +738,15 @@ var Manual = map[string]ManualEntry{
 		},
 		Example: `list_gitea(resource="repository", scope="my")`,
 	},
+	"list:repo_watch": {
+		Action:      ActionList,
+		Resource:    ResourceRepoWatch,
+		Description: "List the repositories you or another user watch.",
+		Params: []ParamSpec{
+			{Name: "user", Type: "string", Required: false, Description: "Username (default yourself)"},
+		},
+		Example: `list_gitea(resource="repo_watch")`,
+	},
 	"list:action_task": {
 		Action:      ActionList,
 		Resource:    ResourceActionTask,
@@ -336,6 +757,24 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `list_gitea(resource="action_task", owner="org", repo="project")`,
 	},
+	"list:action_workflow": {
+		Action:      ActionList,
+		Resource:    ResourceActionWorkflow,
+		Description: "List workflow files under .forgejo/workflows, .gitea/workflows and .github/workflows, with triggers, jobs, runs-on labels and schema errors. Forgejo only runs the first of these directories that exists; files in the others are marked inactive.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "ref", Type: "string", Required: false, Description: "Branch, tag or commit (default: default branch)"},
+		),
+		Example: `list_gitea(resource="action_workflow", owner="org", repo="project", ref="feature/ci")`,
+	},
+	"list:action_artifact": {
+		Action:      ActionList,
+		Resource:    ResourceActionArtifact,
+		Description: "List Forgejo Actions artifacts of a repository, or of a single workflow run. Needs a server with the artifacts API (Gitea 1.23+; not Forgejo 11).",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "run_id", Type: "integer", Required: false, Description: "Only artifacts of this workflow run"},
+		),
+		Example: `list_gitea(resource="action_artifact", owner="org", repo="project", run_id=45)`,
+	},
 	"list:issue_dependency": {
 		Action:      ActionList,
 		Resource:    Resource("issue_dependency"),
@@ -354,22 +793,44 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `list_gitea(resource="issue_blocking", owner="org", repo="project", index=42)`,
 	},
+	"list:action_variable": {
+		Action:      ActionList,
+		Resource:    ResourceActionVariable,
+		Description: "List Forgejo Actions variables (with values) of a repository, organization or the user.",
+		Params:      actionScopeParams(),
+		Example:     `list_gitea(resource="action_variable", scope="org", org="myorg")`,
+	},
 
 	// === EDIT ===
 	"edit:issue": {
 		Action:      ActionEdit,
 		Resource:    ResourceIssue,
-		Description: "Edit an existing issue.",
+		Description: "Edit an existing issue, including pinning it.",
 		Params: append(commonRepoParams(),
 			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue number"},
 			ParamSpec{Name: "title", Type: "string", Required: false, Description: "New title"},
 			ParamSpec{Name: "body", Type: "string", Required: false, Description: "New body"},
 			ParamSpec{Name: "state", Type: "string", Required: false, Description: "New state", Enum: []string{"open", "closed"}},
-			ParamSpec{Name: "assignees", Type: "array", Required: false, Description: "New assignees"},
-			ParamSpec{Name: "milestone", Type: "integer", Required: false, Description: "New milestone ID"},
+			ParamSpec{Name: "assignees", Type: "array", Required: false, Description: "New assignees, by username or user ID"},
+			ParamSpec{Name: "milestone", Type: "integer|string", Required: false, Description: "New milestone title or ID"},
 			ParamSpec{Name: "due_date", Type: "string", Required: false, Description: "New due date (RFC3339)"},
+			ParamSpec{Name: "pinned", Type: "boolean", Required: false, Description: "Pin (true) or unpin (false) the issue"},
+		),
+		Example: `edit_gitea(resource="issue", owner="org", repo="project", index=42, pinned=true)`,
+	},
+	"edit:issue_bulk": {
+		Action:      ActionEdit,
+		Resource:    ResourceIssueBulk,
+		Description: "Apply one change to many issues at once, selected by indexes or by a list:issue filter. Returns a per-issue success/failure table.",
+		Params: append(bulkIssueParams(),
+			ParamSpec{Name: "state", Type: "string", Required: false, Description: "Close or reopen the issues", Enum: []string{"open", "closed"}},
+			ParamSpec{Name: "milestone", Type: "integer|string", Required: false, Description: "Milestone title or ID to set, 0 to clear"},
+			ParamSpec{Name: "assignees", Type: "array", Required: false, Description: "Usernames replacing the current assignees"},
+			ParamSpec{Name: "add_labels", Type: "array", Required: false, Description: "Label names or IDs to add"},
+			ParamSpec{Name: "replace_scoped", Type: "boolean", Required: false, Description: "With add_labels, remove other labels in the scope of an added label"},
+			ParamSpec{Name: "remove_labels", Type: "array", Required: false, Description: "Label names or IDs to remove"},
 		),
-		Example: `edit_gitea(resource="issue", owner="org", repo="project", index=42, state="closed")`,
+		Example: `edit_gitea(resource="issue_bulk", owner="org", repo="project", filter={"labels": "stale", "state": "open"}, state="closed")`,
 	},
 	"edit:issue_comment": {
 		Action:      ActionEdit,
@@ -381,6 +842,15 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `edit_gitea(resource="issue_comment", owner="org", repo="project", id=123, body="Updated comment")`,
 	},
+	"edit:stopwatch": {
+		Action:      ActionEdit,
+		Resource:    ResourceStopwatch,
+		Description: "Stop the running stopwatch on an issue and record the elapsed time as tracked time.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue or pull request number"},
+		),
+		Example: `edit_gitea(resource="stopwatch", owner="org", repo="project", index=42)`,
+	},
 	"edit:issue_attachment": {
 		Action:      ActionEdit,
 		Resource:    ResourceIssueAttachment,
@@ -395,21 +865,38 @@ var Manual = map[string]ManualEntry{
 	"edit:label": {
 		Action:      ActionEdit,
 		Resource:    ResourceLabel,
-		Description: "Edit a label.",
-		Params: append(commonRepoParams(),
-			ParamSpec{Name: "id", Type: "integer", Required: true, Description: "Label ID"},
+		Description: "Edit a label of a repository, or of an organization when only org is given.",
+		Params: append(labelScopeParams(),
+			ParamSpec{Name: "id", Type: "integer|string", Required: true, Description: "Label ID or name"},
 			ParamSpec{Name: "name", Type: "string", Required: false, Description: "New name"},
 			ParamSpec{Name: "color", Type: "string", Required: false, Description: "New color (hex without #)"},
 			ParamSpec{Name: "description", Type: "string", Required: false, Description: "New description"},
+			ParamSpec{Name: "exclusive", Type: "boolean", Required: false, Description: "Make the label exclusive within its scope, or not"},
 		),
 		Example: `edit_gitea(resource="label", owner="org", repo="project", id=1, color="00ff00")`,
 	},
+	"edit:label_sync": {
+		Action:      ActionEdit,
+		Resource:    ResourceLabelSync,
+		Description: "Apply a canonical label set (name, color, description, exclusive) to many repositories. The set comes from a YAML spec, a source repository or the labels of source_org. Labels are matched by name, or renamed from an alias so issues keep them; with prune, labels outside the set are deleted. Runs as a dry run listing what would be created, updated, renamed or deleted unless confirm=true.",
+		Params: []ParamSpec{
+			{Name: "spec", Type: "string", Required: false, Description: "YAML list of labels with name, color, description, exclusive and aliases (former names)"},
+			{Name: "owner", Type: "string", Required: false, Description: "Source repository owner (without spec or source_org)"},
+			{Name: "repo", Type: "string", Required: false, Description: "Source repository name (without spec or source_org)"},
+			{Name: "source_org", Type: "string", Required: false, Description: "Take the set from the labels of this organization"},
+			{Name: "repos", Type: "array", Required: false, Description: "Target repositories as 'owner/repo'"},
+			{Name: "org", Type: "string", Required: false, Description: "Sync to every non-archived repository of this organization"},
+			{Name: "prune", Type: "boolean", Required: false, Description: "Delete labels of the targets that are not in the set (default false)"},
+			{Name: "confirm", Type: "boolean", Required: false, Description: "Apply the changes; without it only the diff is shown"},
+		},
+		Example: `edit_gitea(resource="label_sync", spec="- name: priority/high\n  color: ee0701\n  exclusive: true\n  aliases: [urgent]", org="org")`,
+	},
 	"edit:milestone": {
 		Action:      ActionEdit,
 		Resource:    ResourceMilestone,
 		Description: "Edit a milestone.",
 		Params: append(commonRepoParams(),
-			ParamSpec{Name: "id", Type: "integer", Required: true, Description: "Milestone ID"},
+			ParamSpec{Name: "id", Type: "integer|string", Required: true, Description: "Milestone ID or title"},
 			ParamSpec{Name: "title", Type: "string", Required: false, Description: "New title"},
 			ParamSpec{Name: "description", Type: "string", Required: false, Description: "New description"},
 			ParamSpec{Name: "due_date", Type: "string", Required: false, Description: "New due date (RFC3339)"},
@@ -417,6 +904,22 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `edit_gitea(resource="milestone", owner="org", repo="project", id=1, state="closed")`,
 	},
+	"edit:milestone_sync": {
+		Action:      ActionEdit,
+		Resource:    ResourceMilestoneSync,
+		Description: "Create or update milestones by title in many repositories, copying title, description, due date and state from a source repository or a YAML spec. Runs as a dry run showing the diff unless confirm=true. Milestones missing from the source are never deleted; empty descriptions and missing due dates leave the target's values alone.",
+		Params: []ParamSpec{
+			{Name: "owner", Type: "string", Required: false, Description: "Source repository owner (required without spec)"},
+			{Name: "repo", Type: "string", Required: false, Description: "Source repository name (required without spec)"},
+			{Name: "spec", Type: "string", Required: false, Description: "YAML list of milestones with title, description, due_on (YYYY-MM-DD) and state, instead of a source repository"},
+			{Name: "titles", Type: "array", Required: false, Description: "Only sync these milestones"},
+			{Name: "include_closed", Type: "boolean", Required: false, Description: "Also copy closed milestones of the source (default false)"},
+			{Name: "repos", Type: "array", Required: false, Description: "Target repositories as 'owner/repo'"},
+			{Name: "org", Type: "string", Required: false, Description: "Sync to every non-archived repository of this organization"},
+			{Name: "confirm", Type: "boolean", Required: false, Description: "Apply the changes; without it only the diff is shown"},
+		},
+		Example: `edit_gitea(resource="milestone_sync", owner="org", repo="api", org="org", titles=["v2.3"], confirm=true)`,
+	},
 	"edit:release": {
 		Action:      ActionEdit,
 		Resource:    ResourceRelease,
@@ -455,6 +958,29 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `edit_gitea(resource="wiki_page", owner="org", repo="project", page_name="Home", content="# Updated")`,
 	},
+	"edit:action_variable": {
+		Action:      ActionEdit,
+		Resource:    ResourceActionVariable,
+		Description: "Change the value (and optionally the name) of a Forgejo Actions variable. The old value is lost, so confirm=true is required.",
+		Params: append(actionScopeParams(),
+			ParamSpec{Name: "name", Type: "string", Required: true, Description: "Current variable name"},
+			ParamSpec{Name: "value", Type: "string", Required: true, Description: "New value"},
+			ParamSpec{Name: "new_name", Type: "string", Required: false, Description: "New variable name"},
+			ParamSpec{Name: "confirm", Type: "boolean", Required: true, Description: "Must be true to overwrite the variable"},
+		),
+		Example: `edit_gitea(resource="action_variable", owner="org", repo="project", name="GO_VERSION", value="1.24", confirm=true)`,
+	},
+	"edit:action_secret": {
+		Action:      ActionEdit,
+		Resource:    ResourceActionSecret,
+		Description: "Replace the value of a Forgejo Actions secret. The old value is lost, so confirm=true is required.",
+		Params: append(actionScopeParams(),
+			ParamSpec{Name: "name", Type: "string", Required: true, Description: "Secret name"},
+			ParamSpec{Name: "value", Type: "string", Required: true, Description: "New secret value"},
+			ParamSpec{Name: "confirm", Type: "boolean", Required: true, Description: "Must be true to overwrite the secret"},
+		),
+		Example: `edit_gitea(resource="action_secret", owner="org", repo="project", name="DEPLOY_KEY", value="...", confirm=true)`,
+	},
 
 	// === DELETE ===
 	"delete:issue_comment": {
@@ -466,6 +992,43 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `delete_gitea(resource="issue_comment", owner="org", repo="project", id=123)`,
 	},
+	"delete:issue_subscription": {
+		Action:      ActionDelete,
+		Resource:    ResourceIssueSubscription,
+		Description: "Unsubscribe from an issue or pull request.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue or pull request number"},
+			ParamSpec{Name: "user", Type: "string", Required: false, Description: "Username to unsubscribe (default yourself, others require admin rights)"},
+		),
+		Example: `delete_gitea(resource="issue_subscription", owner="org", repo="project", index=42)`,
+	},
+	"delete:reaction": {
+		Action:      ActionDelete,
+		Resource:    ResourceReaction,
+		Description: "Remove your reaction from an issue, pull request or comment.",
+		Params:      append(reactionParams(), reactionContentParam()),
+		Example:     `delete_gitea(resource="reaction", owner="org", repo="project", index=42, content="eyes")`,
+	},
+	"delete:tracked_time": {
+		Action:      ActionDelete,
+		Resource:    ResourceTrackedTime,
+		Description: "Delete a tracked time entry.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue or pull request number"},
+			ParamSpec{Name: "id", Type: "integer", Required: true, Description: "Tracked time ID"},
+		),
+		Example: `delete_gitea(resource="tracked_time", owner="org", repo="project", index=42, id=15)`,
+	},
+	"delete:stopwatch": {
+		Action:      ActionDelete,
+		Resource:    ResourceStopwatch,
+		Description: "Cancel the running stopwatch on an issue without recording time.",
+		Params: append(commonRepoParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue or pull request number"},
+		),
+		Example: `delete_gitea(resource="stopwatch", owner="org", repo="project", index=42)`,
+	},
+
 	"delete:issue_attachment": {
 		Action:      ActionDelete,
 		Resource:    ResourceIssueAttachment,
@@ -479,9 +1042,9 @@ var Manual = map[string]ManualEntry{
 	"delete:label": {
 		Action:      ActionDelete,
 		Resource:    ResourceLabel,
-		Description: "Delete a label from a repository.",
-		Params: append(commonRepoParams(),
-			ParamSpec{Name: "id", Type: "integer", Required: true, Description: "Label ID"},
+		Description: "Delete a label from a repository, or from an organization when only org is given.",
+		Params: append(labelScopeParams(),
+			ParamSpec{Name: "id", Type: "integer|string", Required: true, Description: "Label ID or name"},
 		),
 		Example: `delete_gitea(resource="label", owner="org", repo="project", id=1)`,
 	},
@@ -490,7 +1053,7 @@ var Manual = map[string]ManualEntry{
 		Resource:    ResourceMilestone,
 		Description: "Delete a milestone.",
 		Params: append(commonRepoParams(),
-			ParamSpec{Name: "id", Type: "integer", Required: true, Description: "Milestone ID"},
+			ParamSpec{Name: "id", Type: "integer|string", Required: true, Description: "Milestone ID or title"},
 		),
 		Example: `delete_gitea(resource="milestone", owner="org", repo="project", id=1)`,
 	},
@@ -522,35 +1085,63 @@ var Manual = map[string]ManualEntry{
 		),
 		Example: `delete_gitea(resource="wiki_page", owner="org", repo="project", page_name="OldPage")`,
 	},
+	"delete:repo_watch": {
+		Action:      ActionDelete,
+		Resource:    ResourceRepoWatch,
+		Description: "Stop watching a repository.",
+		Params:      commonRepoParams(),
+		Example:     `delete_gitea(resource="repo_watch", owner="org", repo="project")`,
+	},
+	"delete:action_variable": {
+		Action:      ActionDelete,
+		Resource:    ResourceActionVariable,
+		Description: "Delete a Forgejo Actions variable. Requires confirm=true.",
+		Params: append(actionScopeParams(),
+			ParamSpec{Name: "name", Type: "string", Required: true, Description: "Variable name"},
+			ParamSpec{Name: "confirm", Type: "boolean", Required: true, Description: "Must be true to delete"},
+		),
+		Example: `delete_gitea(resource="action_variable", owner="org", repo="project", name="OLD_VAR", confirm=true)`,
+	},
+	"delete:action_secret": {
+		Action:      ActionDelete,
+		Resource:    ResourceActionSecret,
+		Description: "Delete a Forgejo Actions secret. Requires confirm=true.",
+		Params: append(actionScopeParams(),
+			ParamSpec{Name: "name", Type: "string", Required: true, Description: "Secret name"},
+			ParamSpec{Name: "confirm", Type: "boolean", Required: true, Description: "Must be true to delete"},
+		),
+		Example: `delete_gitea(resource="action_secret", scope="user", name="OLD_TOKEN", confirm=true)`,
+	},
 
 	// === LINK ===
 	"link:issue_label": {
 		Action:      ActionLink,
 		LinkType:    LinkIssueLabel,
-		Description: "Add labels to an issue.",
-		Params: append(commonRepoParams(),
-			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue number"},
-			ParamSpec{Name: "labels", Type: "array", Required: true, Description: "Label IDs to add"},
+		Description: "Add labels to an issue, or to many issues when indexes or filter is given instead of index. Adding an exclusive scoped label (e.g. priority/high) makes Forgejo drop the issue's other labels of that scope; the result lists what was replaced, and warns when non-exclusive labels of the same scope remain.",
+		Params: append(bulkIssueParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: false, Description: "Issue number (required without indexes or filter)"},
+			ParamSpec{Name: "labels", Type: "array", Required: true, Description: "Label names or IDs to add"},
+			ParamSpec{Name: "replace_scoped", Type: "boolean", Required: false, Description: "Remove the issue's other labels in the scope of an added label, even when the scope is not exclusive (default false)"},
 		),
-		Example: `link_gitea(type="issue_label", owner="org", repo="project", index=42, labels=[1, 2])`,
+		Example: `link_gitea(type="issue_label", owner="org", repo="project", index=42, labels=["bug", "priority/high"])`,
 	},
 	"link:issue_dependency": {
 		Action:      ActionLink,
 		LinkType:    LinkIssueDependency,
-		Description: "Add a dependency: issue cannot be closed until dependency_index is closed.",
-		Params: append(commonRepoParams(),
-			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Dependent issue number"},
-			ParamSpec{Name: "dependency_index", Type: "integer", Required: true, Description: "Issue that blocks this one"},
+		Description: "Add a dependency: issue cannot be closed until dependency_index is closed. Either side may be in another repository: pass it as 'owner/repo#123' or as an issue URL.",
+		Params: issueLinkParams(
+			ParamSpec{Name: "index", Type: "integer|string", Required: true, Description: "Dependent issue: number, 'owner/repo#123' or URL"},
+			ParamSpec{Name: "dependency_index", Type: "integer|string", Required: true, Description: "Issue that blocks this one: number in the same repo, 'owner/repo#123' or URL"},
 		),
-		Example: `link_gitea(type="issue_dependency", owner="org", repo="project", index=42, dependency_index=10)`,
+		Example: `link_gitea(type="issue_dependency", owner="org", repo="frontend", index=42, dependency_index="org/backend#10")`,
 	},
 	"link:issue_blocking": {
 		Action:      ActionLink,
 		LinkType:    LinkIssueBlocking,
-		Description: "Add a blocking relationship: blocked_index cannot be closed until index is closed.",
-		Params: append(commonRepoParams(),
-			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Blocking issue number"},
-			ParamSpec{Name: "blocked_index", Type: "integer", Required: true, Description: "Issue that will be blocked"},
+		Description: "Add a blocking relationship: blocked_index cannot be closed until index is closed. Either side may be in another repository: pass it as 'owner/repo#123' or as an issue URL.",
+		Params: issueLinkParams(
+			ParamSpec{Name: "index", Type: "integer|string", Required: true, Description: "Blocking issue: number, 'owner/repo#123' or URL"},
+			ParamSpec{Name: "blocked_index", Type: "integer|string", Required: true, Description: "Issue that will be blocked: number in the same repo, 'owner/repo#123' or URL"},
 		),
 		Example: `link_gitea(type="issue_blocking", owner="org", repo="project", index=42, blocked_index=50)`,
 	},
@@ -559,30 +1150,30 @@ var Manual = map[string]ManualEntry{
 	"unlink:issue_label": {
 		Action:      ActionUnlink,
 		LinkType:    LinkIssueLabel,
-		Description: "Remove a label from an issue.",
-		Params: append(commonRepoParams(),
-			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Issue number"},
-			ParamSpec{Name: "label_id", Type: "integer", Required: true, Description: "Label ID to remove"},
+		Description: "Remove a label from an issue, or from many issues when indexes or filter is given instead of index.",
+		Params: append(bulkIssueParams(),
+			ParamSpec{Name: "index", Type: "integer", Required: false, Description: "Issue number (required without indexes or filter)"},
+			ParamSpec{Name: "label_id", Type: "integer|string", Required: true, Description: "Label name or ID to remove"},
 		),
-		Example: `unlink_gitea(type="issue_label", owner="org", repo="project", index=42, label_id=1)`,
+		Example: `unlink_gitea(type="issue_label", owner="org", repo="project", index=42, label_id="bug")`,
 	},
 	"unlink:issue_dependency": {
 		Action:      ActionUnlink,
 		LinkType:    LinkIssueDependency,
-		Description: "Remove a dependency relationship.",
-		Params: append(commonRepoParams(),
-			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Dependent issue number"},
-			ParamSpec{Name: "dependency_index", Type: "integer", Required: true, Description: "Dependency to remove"},
+		Description: "Remove a dependency relationship. Issues accept the same references as link_gitea.",
+		Params: issueLinkParams(
+			ParamSpec{Name: "index", Type: "integer|string", Required: true, Description: "Dependent issue: number, 'owner/repo#123' or URL"},
+			ParamSpec{Name: "dependency_index", Type: "integer|string", Required: true, Description: "Dependency to remove: number in the same repo, 'owner/repo#123' or URL"},
 		),
 		Example: `unlink_gitea(type="issue_dependency", owner="org", repo="project", index=42, dependency_index=10)`,
 	},
 	"unlink:issue_blocking": {
 		Action:      ActionUnlink,
 		LinkType:    LinkIssueBlocking,
-		Description: "Remove a blocking relationship.",
-		Params: append(commonRepoParams(),
-			ParamSpec{Name: "index", Type: "integer", Required: true, Description: "Blocking issue number"},
-			ParamSpec{Name: "blocked_index", Type: "integer", Required: true, Description: "Issue to unblock"},
+		Description: "Remove a blocking relationship. Issues accept the same references as link_gitea.",
+		Params: issueLinkParams(
+			ParamSpec{Name: "index", Type: "integer|string", Required: true, Description: "Blocking issue: number, 'owner/repo#123' or URL"},
+			ParamSpec{Name: "blocked_index", Type: "integer|string", Required: true, Description: "Issue to unblock: number in the same repo, 'owner/repo#123' or URL"},
 		),
 		Example: `unlink_gitea(type="issue_blocking", owner="org", repo="project", index=42, blocked_index=50)`,
 	},
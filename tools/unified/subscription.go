@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"fmt"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+func (impl ListImpl) listIssueSubscriptions(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_subscription", err.Error()))
+	}
+
+	index, ok := args["index"].(float64)
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_subscription", "index is required"))
+	}
+
+	users, _, err := impl.Client.GetIssueSubscribers(owner, repo, int64(index))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list subscribers: %w", err)
+	}
+	me, _, err := impl.Client.CheckIssueSubscription(owner, repo, int64(index))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check subscription: %w", err)
+	}
+
+	return textResult((&types.IssueSubscribers{Users: users, Me: me}).ToMarkdown()), nil, nil
+}
+
+func (impl CreateImpl) createIssueSubscription(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue_subscription", err.Error()))
+	}
+
+	index, ok := args["index"].(float64)
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "issue_subscription", "index is required"))
+	}
+
+	who := "You are"
+	if user, _ := args["user"].(string); user != "" {
+		who = "@" + user + " is"
+		_, err = impl.Client.AddIssueSubscription(owner, repo, int64(index), user)
+	} else {
+		_, err = impl.Client.IssueSubscribe(owner, repo, int64(index))
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("%s now subscribed to issue #%d.", who, int64(index))), nil, nil
+}
+
+func (impl DeleteImpl) deleteIssueSubscription(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "issue_subscription", err.Error()))
+	}
+
+	index, ok := args["index"].(float64)
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "issue_subscription", "index is required"))
+	}
+
+	who := "You are"
+	if user, _ := args["user"].(string); user != "" {
+		who = "@" + user + " is"
+		_, err = impl.Client.DeleteIssueSubscription(owner, repo, int64(index), user)
+	} else {
+		_, err = impl.Client.IssueUnSubscribe(owner, repo, int64(index))
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("%s no longer subscribed to issue #%d.", who, int64(index))), nil, nil
+}
+
+func (impl ListImpl) listRepoWatches(args map[string]any) (*mcp.CallToolResult, any, error) {
+	var (
+		repos []*forgejo.Repository
+		err   error
+	)
+	if user, _ := args["user"].(string); user != "" {
+		repos, _, err = impl.Client.GetWatchedRepos(user)
+	} else {
+		repos, _, err = impl.Client.GetMyWatchedRepos()
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list watched repositories: %w", err)
+	}
+
+	if len(repos) == 0 {
+		return textResult("No watched repositories found."), nil, nil
+	}
+
+	repoList := make(types.RepositoryList, len(repos))
+	for i, r := range repos {
+		repoList[i] = &types.Repository{Repository: r}
+	}
+	return textResult(fmt.Sprintf("Found %d watched repositories\n\n%s", len(repos), repoList.ToMarkdown())), nil, nil
+}
+
+func (impl CreateImpl) createRepoWatch(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "repo_watch", err.Error()))
+	}
+
+	_, err = impl.Client.WatchRepo(owner, repo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to watch repository: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("You are now watching %s/%s.", owner, repo)), nil, nil
+}
+
+func (impl DeleteImpl) deleteRepoWatch(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "repo_watch", err.Error()))
+	}
+
+	_, err = impl.Client.UnWatchRepo(owner, repo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to unwatch repository: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("You are no longer watching %s/%s.", owner, repo)), nil, nil
+}
@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/tools"
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// loadIssueTemplates reads the issue and pull request templates of a
+// repository from every directory in types.TemplateDirs.
+func loadIssueTemplates(client *tools.Client, owner, repo, ref string) (types.IssueTemplateList, error) {
+	var list types.IssueTemplateList
+	load := func(kind, filePath string) error {
+		data, _, err := client.GetFile(owner, repo, ref, filePath)
+		if err != nil {
+			return fmt.Errorf("failed to get %s: %w", filePath, err)
+		}
+		list = append(list, types.ParseIssueTemplate(kind, filePath, data))
+		return nil
+	}
+
+	for _, dir := range types.TemplateDirs {
+		entries, resp, err := client.ListContents(owner, repo, ref, dir)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list %s: %w", dir, err)
+		}
+
+		for _, entry := range entries {
+			if entry.Type == "file" {
+				if kind := types.TemplateKind("", entry.Name); kind != "" {
+					if err := load(kind, entry.Path); err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
+			if entry.Type != "dir" || !strings.EqualFold(entry.Name, "ISSUE_TEMPLATE") {
+				continue
+			}
+
+			files, _, err := client.ListContents(owner, repo, ref, entry.Path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s: %w", entry.Path, err)
+			}
+			for _, file := range files {
+				kind := types.TemplateKind(entry.Name, file.Name)
+				if file.Type != "file" || kind == "" {
+					continue
+				}
+				if err := load(kind, file.Path); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return list, nil
+}
+
+// findIssueTemplate returns the template of the given kind matching name.
+func findIssueTemplate(list types.IssueTemplateList, kind, name string) (*types.IssueTemplate, error) {
+	var names []string
+	for _, t := range list {
+		if kind != "" && t.Kind != kind {
+			continue
+		}
+		if t.Matches(name) {
+			return t, nil
+		}
+		names = append(names, t.Name)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("template %q not found, the repository has no templates", name)
+	}
+	return nil, fmt.Errorf("template %q not found, available: %s", name, strings.Join(names, ", "))
+}
+
+func (impl ListImpl) listIssueTemplates(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "issue_template", err.Error()))
+	}
+	ref, _ := args["ref"].(string)
+	kind, _ := args["kind"].(string)
+
+	templates, err := loadIssueTemplates(impl.Client, owner, repo, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var list types.IssueTemplateList
+	for _, t := range templates {
+		if kind == "" || t.Kind == kind {
+			list = append(list, t)
+		}
+	}
+	if len(list) == 0 {
+		return textResult(fmt.Sprintf("No templates found in %s.", strings.Join(types.TemplateDirs, ", "))), nil, nil
+	}
+
+	return textResult(fmt.Sprintf("Found %d templates\n\n%s", len(list), list.ToMarkdown())), nil, nil
+}
+
+func (impl GetImpl) getIssueTemplate(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "issue_template", err.Error()))
+	}
+	name, _ := args["name"].(string)
+	if name == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "issue_template", "name is required"))
+	}
+	ref, _ := args["ref"].(string)
+	kind, _ := args["kind"].(string)
+
+	templates, err := loadIssueTemplates(impl.Client, owner, repo, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	tmpl, err := findIssueTemplate(templates, kind, name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "issue_template", err.Error()))
+	}
+
+	return textResult(tmpl.ToMarkdown()), nil, nil
+}
@@ -0,0 +1,253 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+const (
+	// timesPageSize is the page size used when loading tracked times.
+	timesPageSize = 50
+	// timesMaxPages bounds the tracked times loaded per repository.
+	timesMaxPages = 100
+)
+
+// parseDateArg reads an optional date argument in YYYY-MM-DD or RFC3339
+// format. Missing arguments yield the zero time.
+func parseDateArg(args map[string]any, key string) (time.Time, error) {
+	s, _ := args[key].(string)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s format (expected YYYY-MM-DD or RFC3339)", key)
+	}
+	return t, nil
+}
+
+// parseDurationArg reads a duration of at least one second, given as seconds
+// or as a Go duration string like '1h30m'. Fractions of a second are dropped.
+func parseDurationArg(args map[string]any, key string) (int64, error) {
+	switch v := args[key].(type) {
+	case float64:
+		if v >= 1 {
+			return int64(v), nil
+		}
+	case string:
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil && n > 0 {
+			return n, nil
+		}
+		if d, err := time.ParseDuration(v); err == nil && d >= time.Second {
+			return int64(d / time.Second), nil
+		}
+	}
+	return 0, fmt.Errorf("%s is required, at least one second (seconds or duration like '1h30m')", key)
+}
+
+// trackedTimeOptions builds the user/since/before filters shared by tracked
+// time listings and reports.
+func trackedTimeOptions(args map[string]any) (forgejo.ListTrackedTimesOptions, error) {
+	opt := forgejo.ListTrackedTimesOptions{}
+	since, err := parseDateArg(args, "since")
+	if err != nil {
+		return opt, err
+	}
+	before, err := parseDateArg(args, "before")
+	if err != nil {
+		return opt, err
+	}
+	opt.Since = since
+	opt.Before = before
+	opt.User, _ = args["user"].(string)
+	return opt, nil
+}
+
+// listAllRepoTrackedTimes pages through the tracked times of a repository.
+// The second return value is false if timesMaxPages was reached first.
+func (impl GetImpl) listAllRepoTrackedTimes(owner, repo string, opt forgejo.ListTrackedTimesOptions) ([]*forgejo.TrackedTime, bool, error) {
+	var ret []*forgejo.TrackedTime
+	opt.PageSize = timesPageSize
+	for opt.Page = 1; opt.Page <= timesMaxPages; opt.Page++ {
+		times, _, err := impl.Client.ListRepoTrackedTimes(owner, repo, opt)
+		if err != nil {
+			return nil, false, err
+		}
+		ret = append(ret, times...)
+		if len(times) < timesPageSize {
+			return ret, true, nil
+		}
+	}
+	return ret, false, nil
+}
+
+func (impl ListImpl) listTrackedTimes(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "tracked_time", err.Error()))
+	}
+
+	opt, err := trackedTimeOptions(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "tracked_time", err.Error()))
+	}
+	if page, ok := args["page"].(float64); ok && page > 0 {
+		opt.Page = int(page)
+	}
+	if limit, ok := args["limit"].(float64); ok && limit > 0 {
+		opt.PageSize = int(limit)
+	}
+
+	var times []*forgejo.TrackedTime
+	if index, ok := args["index"].(float64); ok && index > 0 {
+		times, _, err = impl.Client.ListIssueTrackedTimes(owner, repo, int64(index), opt)
+	} else {
+		times, _, err = impl.Client.ListRepoTrackedTimes(owner, repo, opt)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list tracked times: %w", err)
+	}
+
+	if len(times) == 0 {
+		return textResult("No tracked times found."), nil, nil
+	}
+
+	return textResult(fmt.Sprintf("Found %d tracked times\n\n%s", len(times), types.TrackedTimeList(times).ToMarkdown())), nil, nil
+}
+
+func (impl CreateImpl) createTrackedTime(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "tracked_time", err.Error()))
+	}
+
+	index, ok := args["index"].(float64)
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "tracked_time", "index is required"))
+	}
+
+	seconds, err := parseDurationArg(args, "duration")
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "tracked_time", err.Error()))
+	}
+
+	created, err := parseDateArg(args, "date")
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "tracked_time", err.Error()))
+	}
+
+	opt := forgejo.AddTimeOption{Time: seconds, Created: created}
+	opt.User, _ = args["user"].(string)
+
+	tracked, _, err := impl.Client.AddTime(owner, repo, int64(index), opt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to add tracked time: %w", err)
+	}
+
+	return textResult("Tracked time added\n\n" + (&types.TrackedTime{TrackedTime: tracked}).ToMarkdown()), nil, nil
+}
+
+func (impl DeleteImpl) deleteTrackedTime(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "tracked_time", err.Error()))
+	}
+
+	index, ok := args["index"].(float64)
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "tracked_time", "index is required"))
+	}
+
+	id, ok := args["id"].(float64)
+	if !ok || id <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "tracked_time", "id is required"))
+	}
+
+	_, err = impl.Client.DeleteTime(owner, repo, int64(index), int64(id))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to delete tracked time: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("Tracked time %d deleted from issue #%d.", int64(id), int64(index))), nil, nil
+}
+
+func (impl ListImpl) listStopwatches(args map[string]any) (*mcp.CallToolResult, any, error) {
+	stopwatches, _, err := impl.Client.GetMyStopwatches()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list stopwatches: %w", err)
+	}
+
+	return textResult(types.StopWatchList(stopwatches).ToMarkdown()), nil, nil
+}
+
+func (impl CreateImpl) createStopwatch(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "stopwatch", err.Error()))
+	}
+
+	index, ok := args["index"].(float64)
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionCreate, "stopwatch", "index is required"))
+	}
+
+	_, err = impl.Client.StartIssueStopWatch(owner, repo, int64(index))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to start stopwatch: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("Stopwatch started on issue #%d.", int64(index))), nil, nil
+}
+
+func (impl EditImpl) editStopwatch(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "stopwatch", err.Error()))
+	}
+
+	index, ok := args["index"].(float64)
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionEdit, "stopwatch", "index is required"))
+	}
+
+	_, err = impl.Client.StopIssueStopWatch(owner, repo, int64(index))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to stop stopwatch: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("Stopwatch stopped on issue #%d, the elapsed time was added as tracked time.", int64(index))), nil, nil
+}
+
+func (impl DeleteImpl) deleteStopwatch(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "stopwatch", err.Error()))
+	}
+
+	index, ok := args["index"].(float64)
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionDelete, "stopwatch", "index is required"))
+	}
+
+	_, err = impl.Client.DeleteIssueStopwatch(owner, repo, int64(index))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to cancel stopwatch: %w", err)
+	}
+
+	return textResult(fmt.Sprintf("Stopwatch on issue #%d cancelled, no time was recorded.", int64(index))), nil, nil
+}
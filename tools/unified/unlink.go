@@ -14,7 +14,6 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
 	"github.com/raohwork/forgejo-mcp/tools"
-	"github.com/raohwork/forgejo-mcp/types"
 )
 
 // UnlinkImpl implements the unlink_gitea tool.
@@ -28,7 +27,7 @@ func (UnlinkImpl) Definition() *mcp.Tool {
 		Name:  "unlink_gitea",
 		Title: "Unlink Gitea Resources",
 		Description: `Remove relationships between resources in Forgejo/Gitea.
-Types: issue_label (remove label from issue), issue_dependency (remove dependency), issue_blocking (remove blocking).
+Types: issue_label (remove label from one or many issues), issue_dependency (remove dependency), issue_blocking (remove blocking).
 Use gitea_manual(action="unlink") for details.`,
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:    false,
@@ -45,14 +44,14 @@ Use gitea_manual(action="unlink") for details.`,
 				},
 				"owner": {
 					Type:        "string",
-					Description: "Repository owner",
+					Description: "Repository owner (optional for issue_dependency and issue_blocking when index is 'owner/repo#123' or a URL)",
 				},
 				"repo": {
 					Type:        "string",
-					Description: "Repository name",
+					Description: "Repository name (optional for issue_dependency and issue_blocking when index is 'owner/repo#123' or a URL)",
 				},
 			},
-			Required:             []string{"type", "owner", "repo"},
+			Required:             []string{"type"},
 			AdditionalProperties: &jsonschema.Schema{},
 		},
 	}
@@ -72,99 +71,75 @@ func (impl UnlinkImpl) Handler() mcp.ToolHandlerFor[map[string]any, any] {
 
 		switch linkType {
 		case "issue_label":
-			return impl.removeIssueLabel(args)
+			return impl.removeIssueLabel(ctx, args)
 		case "issue_dependency":
 			return impl.removeIssueDependency(args)
 		case "issue_blocking":
 			return impl.removeIssueBlocking(args)
 		default:
-			return nil, nil, fmt.Errorf(FormatValidationError(ActionUnlink, linkType, "not implemented"))
+			return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionUnlink, linkType, "not implemented"))
 		}
 	}
 }
 
-func (impl UnlinkImpl) removeIssueLabel(args map[string]any) (*mcp.CallToolResult, any, error) {
+func (impl UnlinkImpl) removeIssueLabel(ctx context.Context, args map[string]any) (*mcp.CallToolResult, any, error) {
 	owner, repo, err := extractOwnerRepo(args)
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionUnlink, "issue_label", err.Error()))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionUnlink, "issue_label", err.Error()))
 	}
 
-	index, ok := args["index"].(float64)
-	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionUnlink, "issue_label", "index is required"))
+	label, ok := args["label_id"]
+	if !ok || label == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionUnlink, "issue_label", "label_id is required"))
+	}
+	ids, err := resolveLabelIDs(impl.Client, owner, repo, []any{label})
+	if err != nil {
+		return nil, nil, resolveError(ActionUnlink, "issue_label", err)
 	}
+	labelID := ids[0]
 
-	labelID, ok := args["label_id"].(float64)
-	if !ok || labelID <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionUnlink, "issue_label", "label_id is required"))
+	index, ok := args["index"].(float64)
+	if (!ok || index <= 0) && isBulk(args) {
+		return issueBulk(ctx, impl.Client, ActionUnlink, "issue_label", args, removeLabelsFunc(impl.Client, owner, repo, ids))
+	}
+	if !ok || index <= 0 {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionUnlink, "issue_label", "index is required"))
 	}
 
-	_, err = impl.Client.DeleteIssueLabel(owner, repo, int64(index), int64(labelID))
+	_, err = impl.Client.DeleteIssueLabel(owner, repo, int64(index), labelID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to remove label: %w", err)
 	}
 
-	return textResult(fmt.Sprintf("Label %d removed from issue #%d", int(labelID), int(index))), nil, nil
+	return textResult(fmt.Sprintf("Label %v removed from issue #%d", label, int(index))), nil, nil
 }
 
 func (impl UnlinkImpl) removeIssueDependency(args map[string]any) (*mcp.CallToolResult, any, error) {
-	owner, repo, err := extractOwnerRepo(args)
+	issue, dependency, err := issueLinkArgs(args, "dependency_index")
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionUnlink, "issue_dependency", err.Error()))
-	}
-
-	index, ok := args["index"].(float64)
-	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionUnlink, "issue_dependency", "index is required"))
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionUnlink, "issue_dependency", err.Error()))
 	}
 
-	dependencyIndex, ok := args["dependency_index"].(float64)
-	if !ok || dependencyIndex <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionUnlink, "issue_dependency", "dependency_index is required"))
-	}
-
-	dependency := types.MyIssueMeta{
-		Owner: owner,
-		Name:  repo,
-		Index: int64(dependencyIndex),
-	}
-
-	_, err = impl.Client.MyRemoveIssueDependency(owner, repo, int64(index), dependency)
+	_, err = impl.Client.MyRemoveIssueDependency(issue.Owner, issue.Name, issue.Index, dependency)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to remove dependency: %w", err)
 	}
 
-	return textResult(fmt.Sprintf("Issue #%d no longer depends on issue #%d",
-		int(index), int(dependencyIndex))), nil, nil
+	return textResult(fmt.Sprintf("Issue %s no longer depends on issue %s",
+		issueArgRef(args, issue), dependency.Ref(issue.Owner, issue.Name))), nil, nil
 }
 
 func (impl UnlinkImpl) removeIssueBlocking(args map[string]any) (*mcp.CallToolResult, any, error) {
-	owner, repo, err := extractOwnerRepo(args)
+	issue, blocked, err := issueLinkArgs(args, "blocked_index")
 	if err != nil {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionUnlink, "issue_blocking", err.Error()))
-	}
-
-	index, ok := args["index"].(float64)
-	if !ok || index <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionUnlink, "issue_blocking", "index is required"))
-	}
-
-	blockedIndex, ok := args["blocked_index"].(float64)
-	if !ok || blockedIndex <= 0 {
-		return nil, nil, fmt.Errorf(FormatValidationError(ActionUnlink, "issue_blocking", "blocked_index is required"))
-	}
-
-	blocked := types.MyIssueMeta{
-		Owner: owner,
-		Name:  repo,
-		Index: int64(blockedIndex),
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionUnlink, "issue_blocking", err.Error()))
 	}
 
-	_, err = impl.Client.MyRemoveIssueBlocking(owner, repo, int64(index), blocked)
+	_, err = impl.Client.MyRemoveIssueBlocking(issue.Owner, issue.Name, issue.Index, blocked)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to remove blocking relationship: %w", err)
 	}
 
-	return textResult(fmt.Sprintf("Issue #%d no longer blocks issue #%d",
-		int(index), int(blockedIndex))), nil, nil
+	return textResult(fmt.Sprintf("Issue %s no longer blocks issue %s",
+		issueArgRef(args, issue), blocked.Ref(issue.Owner, issue.Name))), nil, nil
 }
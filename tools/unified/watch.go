@@ -0,0 +1,144 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+const (
+	defaultWatchTimeout  = 10 * time.Minute
+	maxWatchTimeout      = time.Hour
+	defaultWatchInterval = 15 * time.Second
+	minWatchInterval     = 5 * time.Second
+
+	// taskPageSize is the page size used when scanning the task history.
+	taskPageSize = 50
+	// watchMaxPages bounds the history scanned when looking for an older run.
+	watchMaxPages = 10
+)
+
+// getActionRun reports the jobs of a workflow run. With wait=true it keeps
+// polling until every job finished, the timeout expired or the client gave up,
+// sending a progress notification each time a job changes state.
+func (impl GetImpl) getActionRun(ctx context.Context, req *mcp.CallToolRequest, args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "action_run", err.Error()))
+	}
+
+	var runNumber int64
+	if n, ok := args["run_number"].(float64); ok && n > 0 {
+		runNumber = int64(n)
+	}
+	sha, _ := args["sha"].(string)
+	wait, _ := args["wait"].(bool)
+
+	timeout := defaultWatchTimeout
+	if n, ok := args["timeout"].(float64); ok && n > 0 {
+		timeout = min(time.Duration(n)*time.Second, maxWatchTimeout)
+	}
+	interval := defaultWatchInterval
+	if n, ok := args["interval"].(float64); ok && n > 0 {
+		interval = max(time.Duration(n)*time.Second, minWatchInterval)
+	}
+
+	watchCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		// seen is the last non-empty group, kept when a poll fails
+		// because the deadline passed
+		seen     types.ActionTaskGroup
+		last     string
+		progress float64
+	)
+	for {
+		group, err := impl.findActionTasks(watchCtx, owner, repo, runNumber, sha)
+		if err != nil && watchCtx.Err() == nil {
+			return nil, nil, fmt.Errorf("failed to list action tasks: %w", err)
+		}
+
+		if len(group) > 0 {
+			seen = group
+			if runNumber <= 0 && sha == "" {
+				// stay on the newest run of the first poll, a push during
+				// the watch must not switch to another run
+				runNumber = group[0].RunNumber
+			}
+			if summary := group.Summary(); summary != last {
+				last = summary
+				progress++
+				notifyProgress(watchCtx, req, progress, summary)
+			}
+			if !wait || group.Finished() {
+				return textResult(group.ToMarkdown()), nil, nil
+			}
+		} else if !wait {
+			return textResult("No matching action run found."), nil, nil
+		}
+
+		select {
+		case <-watchCtx.Done():
+			if ctx.Err() != nil {
+				return nil, nil, ctx.Err()
+			}
+			if len(seen) == 0 {
+				return textResult(fmt.Sprintf("Timed out after %s, no matching action run appeared.", timeout)), nil, nil
+			}
+			return textResult(fmt.Sprintf("Timed out after %s, the run is not finished yet.\n\n%s", timeout, seen.ToMarkdown())), nil, nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// findActionTasks loads recent tasks and selects the watched ones. Older runs
+// are searched page by page as long as the requested run number may still be
+// further back in the history.
+func (impl GetImpl) findActionTasks(ctx context.Context, owner, repo string, runNumber int64, sha string) (types.ActionTaskGroup, error) {
+	var tasks []*types.MyActionTask
+	for page := 1; page <= watchMaxPages; page++ {
+		response, err := impl.Client.MyListActionTasksContext(ctx, owner, repo, page, taskPageSize)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, response.WorkflowRuns...)
+
+		if runNumber <= 0 || len(response.WorkflowRuns) < taskPageSize {
+			break
+		}
+		if oldest := response.WorkflowRuns[len(response.WorkflowRuns)-1]; oldest.RunNumber < runNumber {
+			break
+		}
+	}
+
+	return types.GroupActionTasks(tasks, runNumber, sha), nil
+}
+
+// notifyProgress sends a progress notification if the client asked for them
+// by passing a progress token. Failures are ignored, the notification is
+// informational only.
+func notifyProgress(ctx context.Context, req *mcp.CallToolRequest, progress float64, message string) {
+	if req == nil || req.Session == nil || req.Params == nil {
+		return
+	}
+	token := req.Params.GetProgressToken()
+	if token == nil {
+		return
+	}
+	_ = req.Session.NotifyProgress(ctx, &mcp.ProgressNotificationParams{
+		ProgressToken: token,
+		Progress:      progress,
+		Message:       message,
+	})
+}
@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package unified
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/raohwork/forgejo-mcp/types"
+)
+
+// isWorkflowFile reports whether a file name looks like a workflow definition.
+func isWorkflowFile(name string) bool {
+	ext := path.Ext(name)
+	return ext == ".yml" || ext == ".yaml"
+}
+
+func (impl ListImpl) listActionWorkflows(args map[string]any) (*mcp.CallToolResult, any, error) {
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionList, "action_workflow", err.Error()))
+	}
+	ref, _ := args["ref"].(string)
+
+	// Forgejo only runs the workflows of the first existing directory, the
+	// files of the others are listed as inactive
+	var (
+		list   types.WorkflowList
+		active string
+	)
+	for _, dir := range types.WorkflowDirs {
+		entries, resp, err := impl.Client.ListContents(owner, repo, ref, dir)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to list %s: %w", dir, err)
+		}
+		shadowedBy := active
+		if active == "" {
+			active = dir
+		}
+
+		for _, entry := range entries {
+			if entry.Type != "file" || !isWorkflowFile(entry.Name) {
+				continue
+			}
+			data, _, err := impl.Client.GetFile(owner, repo, ref, entry.Path)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get %s: %w", entry.Path, err)
+			}
+			w := types.ParseWorkflow(entry.Path, data)
+			w.ShadowedBy = shadowedBy
+			list = append(list, w)
+		}
+	}
+
+	if len(list) == 0 {
+		return textResult(fmt.Sprintf("No workflow files found in %s.", strings.Join(types.WorkflowDirs, " or "))), nil, nil
+	}
+
+	return textResult(fmt.Sprintf("Found %d workflow files\n\n%s", len(list), list.ToMarkdown())), nil, nil
+}
+
+func (impl GetImpl) getActionWorkflow(args map[string]any) (*mcp.CallToolResult, any, error) {
+	filePath, _ := args["path"].(string)
+
+	// validate an unsaved edit without touching the repository
+	if content, ok := args["content"].(string); ok && content != "" {
+		if filePath == "" {
+			filePath = "workflow.yml"
+		}
+		return textResult(types.ParseWorkflow(filePath, []byte(content)).ToMarkdown()), nil, nil
+	}
+
+	owner, repo, err := extractOwnerRepo(args)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "action_workflow", err.Error()))
+	}
+	if filePath == "" {
+		return nil, nil, fmt.Errorf("%s", FormatValidationError(ActionGet, "action_workflow", "path or content is required"))
+	}
+	if !strings.Contains(filePath, "/") {
+		filePath = types.WorkflowDirs[0] + "/" + filePath
+	}
+	ref, _ := args["ref"].(string)
+
+	data, _, err := impl.Client.GetFile(owner, repo, ref, filePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get %s: %w", filePath, err)
+	}
+
+	return textResult(types.ParseWorkflow(filePath, data).ToMarkdown()), nil, nil
+}
@@ -121,3 +121,107 @@ func TestActionTaskList_ToMarkdown(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupActionTasks(t *testing.T) {
+	tasks := []*MyActionTask{
+		{ID: 5, Name: "lint", RunNumber: 13, HeadSHA: "bbbb2222", Status: "running"},
+		{ID: 4, Name: "test", RunNumber: 12, HeadSHA: "aaaa1111", Status: "failure"},
+		{ID: 3, Name: "build", RunNumber: 12, HeadSHA: "aaaa1111", Status: "success"},
+		{ID: 2, Name: "docs", RunNumber: 11, HeadSHA: "aaaa1111", Status: "success"},
+	}
+
+	tests := []struct {
+		name      string
+		runNumber int64
+		sha       string
+		ids       []int64
+	}{
+		{name: "newest run by default", ids: []int64{5}},
+		{name: "by run number", runNumber: 12, ids: []int64{4, 3}},
+		{name: "by commit prefix across runs", sha: "aaaa", ids: []int64{4, 3, 2}},
+		{name: "no match", runNumber: 99},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group := GroupActionTasks(tasks, tt.runNumber, tt.sha)
+			if len(group) != len(tt.ids) {
+				t.Fatalf("expected %d tasks, got %d", len(tt.ids), len(group))
+			}
+			for i, task := range group {
+				if task.ID != tt.ids[i] {
+					t.Errorf("expected task %d at %d, got %d", tt.ids[i], i, task.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestActionTaskGroup_Status(t *testing.T) {
+	tests := []struct {
+		name     string
+		group    ActionTaskGroup
+		status   string
+		finished bool
+	}{
+		{
+			name:     "all succeeded",
+			group:    ActionTaskGroup{{Status: "success"}, {Status: "skipped"}},
+			status:   "success",
+			finished: true,
+		},
+		{
+			name:     "still running",
+			group:    ActionTaskGroup{{Status: "success"}, {Status: "waiting"}},
+			status:   "running",
+			finished: false,
+		},
+		{
+			name:     "failure wins over running",
+			group:    ActionTaskGroup{{Status: "running"}, {Status: "failure"}},
+			status:   "failure",
+			finished: false,
+		},
+		{
+			name:     "cancelled",
+			group:    ActionTaskGroup{{Status: "cancelled"}, {Status: "success"}},
+			status:   "cancelled",
+			finished: true,
+		},
+		{
+			name:     "empty group is never finished",
+			group:    ActionTaskGroup{},
+			status:   "success",
+			finished: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.group.Status(); got != tt.status {
+				t.Errorf("expected status %s, got %s", tt.status, got)
+			}
+			if got := tt.group.Finished(); got != tt.finished {
+				t.Errorf("expected finished %v, got %v", tt.finished, got)
+			}
+		})
+	}
+}
+
+func TestActionTaskGroup_ToMarkdown(t *testing.T) {
+	started := testTime()
+	group := ActionTaskGroup{
+		{Name: "build", WorkflowID: "ci.yml", RunNumber: 12, Status: "success", RunStartedAt: started, UpdatedAt: started.Add(90 * time.Second)},
+		{Name: "test", WorkflowID: "ci.yml", RunNumber: 12, Status: "running", RunStartedAt: started},
+	}
+
+	assertContains(t, group.ToMarkdown(), []string{
+		"Status: `running` (1/2 jobs done)",
+		"- **build** (ci.yml, run #12) `success` | Duration: 1m30s",
+		"- **test** (ci.yml, run #12) `running`",
+	})
+	assertContains(t, group.Summary(), []string{
+		"1/2 jobs done", "build (run #12) `success`", "test (run #12) `running`",
+	})
+	assertContains(t, ActionTaskGroup{}.ToMarkdown(), []string{"No action tasks found"})
+}
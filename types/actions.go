@@ -8,6 +8,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -81,3 +82,115 @@ type MyActionTaskResponse struct {
 	TotalCount   int64           `json:"total_count"`
 	WorkflowRuns []*MyActionTask `json:"workflow_runs"`
 }
+
+// IsFinalActionStatus reports whether a task status will not change anymore.
+func IsFinalActionStatus(status string) bool {
+	switch status {
+	case "success", "failure", "cancelled", "skipped":
+		return true
+	}
+	return false
+}
+
+// ActionTaskGroup collects the tasks (jobs) of a workflow run, or of every run
+// triggered by the same commit, so they can be watched together.
+type ActionTaskGroup []*MyActionTask
+
+// GroupActionTasks selects tasks from a newest-first task list. A positive
+// runNumber selects that run, a non-empty sha selects every task whose head
+// commit starts with it. Without either, the tasks of the newest run are
+// returned.
+func GroupActionTasks(tasks []*MyActionTask, runNumber int64, sha string) ActionTaskGroup {
+	if runNumber <= 0 && sha == "" {
+		for _, t := range tasks {
+			if t.RunNumber > runNumber {
+				runNumber = t.RunNumber
+			}
+		}
+	}
+
+	var ret ActionTaskGroup
+	for _, t := range tasks {
+		if runNumber > 0 && t.RunNumber != runNumber {
+			continue
+		}
+		if sha != "" && !strings.HasPrefix(t.HeadSHA, sha) {
+			continue
+		}
+		ret = append(ret, t)
+	}
+	return ret
+}
+
+// Finished reports whether every task reached a final status.
+func (g ActionTaskGroup) Finished() bool {
+	if len(g) == 0 {
+		return false
+	}
+	for _, t := range g {
+		if !IsFinalActionStatus(t.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// Done returns the number of tasks in a final status.
+func (g ActionTaskGroup) Done() int {
+	ret := 0
+	for _, t := range g {
+		if IsFinalActionStatus(t.Status) {
+			ret++
+		}
+	}
+	return ret
+}
+
+// Status summarizes the group: "failure" or "cancelled" as soon as any task
+// ended that way, "running" while tasks are unfinished, "success" otherwise.
+func (g ActionTaskGroup) Status() string {
+	status := "success"
+	for _, t := range g {
+		switch {
+		case t.Status == "failure":
+			return "failure"
+		case t.Status == "cancelled":
+			status = "cancelled"
+		case !IsFinalActionStatus(t.Status) && status == "success":
+			status = "running"
+		}
+	}
+	return status
+}
+
+// Summary describes the state of every task in one line, used for progress
+// notifications
+// Example: 1/2 jobs done: build (run #12) `success`, test (run #12) `running`
+func (g ActionTaskGroup) Summary() string {
+	states := make([]string, 0, len(g))
+	for _, t := range g {
+		states = append(states, fmt.Sprintf("%s (run #%d) `%s`", t.Name, t.RunNumber, t.Status))
+	}
+	return fmt.Sprintf("%d/%d jobs done: %s", g.Done(), len(g), strings.Join(states, ", "))
+}
+
+// ToMarkdown renders the overall status followed by every job
+// Example:
+// Status: `failure` (2/2 jobs done)
+//
+// - **build** (ci.yml, run #12) `success` | Duration: 1m30s
+// - **test** (ci.yml, run #12) `failure` | Duration: 3m2s
+func (g ActionTaskGroup) ToMarkdown() string {
+	if len(g) == 0 {
+		return "*No action tasks found*"
+	}
+	markdown := fmt.Sprintf("Status: `%s` (%d/%d jobs done)\n\n", g.Status(), g.Done(), len(g))
+	for _, t := range g {
+		markdown += fmt.Sprintf("- **%s** (%s, run #%d) `%s`", t.Name, t.WorkflowID, t.RunNumber, t.Status)
+		if IsFinalActionStatus(t.Status) && !t.RunStartedAt.IsZero() && !t.UpdatedAt.IsZero() {
+			markdown += " | Duration: " + t.UpdatedAt.Sub(t.RunStartedAt).String()
+		}
+		markdown += "\n"
+	}
+	return markdown
+}
@@ -0,0 +1,154 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// testArtifactZip builds an in-memory zip archive from name/content pairs
+func testArtifactZip(t *testing.T, files ...string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for i := 0; i+1 < len(files); i += 2 {
+		f, err := w.Create(files[i])
+		if err != nil {
+			t.Fatalf("cannot create zip entry: %v", err)
+		}
+		f.Write([]byte(files[i+1]))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("cannot close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestActionArtifactList_ToMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     ActionArtifactList
+		required []string
+	}{
+		{
+			name: "artifacts with run and expiry",
+			list: ActionArtifactList{
+				MyActionArtifactResponse: &MyActionArtifactResponse{
+					TotalCount: 2,
+					Artifacts: []*MyActionArtifact{
+						{
+							ID:          12,
+							Name:        "test-reports",
+							SizeInBytes: 20480,
+							WorkflowRun: &MyActionArtifactRun{ID: 45},
+							ExpiresAt:   testTime(),
+						},
+						{
+							ID:      13,
+							Name:    "coverage",
+							Expired: true,
+						},
+					},
+				},
+			},
+			required: []string{"**test-reports** #12", "20480 bytes", "Run: 45", "Expires: 2024-01-15", "**coverage** #13", "`EXPIRED`"},
+		},
+		{
+			name:     "nil response",
+			list:     ActionArtifactList{},
+			required: []string{"No artifacts found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := tt.list.ToMarkdown()
+			assertContains(t, output, tt.required)
+		})
+	}
+}
+
+func TestParseArtifactArchive(t *testing.T) {
+	data := testArtifactZip(t,
+		"reports/junit.xml", "<testsuite failures=\"1\"></testsuite>\n",
+		"coverage.txt", "total: 81.2%\n",
+		"bin/app", "\x7fELF\x00\x01",
+	)
+
+	entries, err := ParseArtifactArchive(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+
+	t.Run("list entries", func(t *testing.T) {
+		assertContains(t, entries.ToMarkdown(), []string{
+			"`reports/junit.xml`", "`coverage.txt`", "`bin/app`", "binary", "text",
+		})
+	})
+
+	t.Run("match by base name glob", func(t *testing.T) {
+		matched := entries.Match([]string{"*.xml"})
+		if len(matched) != 1 || matched[0].Name != "reports/junit.xml" {
+			t.Fatalf("expected junit.xml only, got %v", matched)
+		}
+	})
+
+	t.Run("read within budget", func(t *testing.T) {
+		matched := entries.Match([]string{"*.xml", "coverage.txt"})
+		if err := matched.ReadText(20); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		output := matched.ToMarkdown()
+		assertContains(t, output, []string{"### reports/junit.xml", "<testsuite failures", "Truncated"})
+		if strings.Contains(output, "81.2%") {
+			t.Errorf("expected budget to be exhausted before coverage.txt, got: %s", output)
+		}
+	})
+
+	t.Run("cut multi-byte character", func(t *testing.T) {
+		entries, err := ParseArtifactArchive(testArtifactZip(t, "notes.txt", "héllo"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// the budget ends inside é
+		if err := entries.ReadText(2); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if entries[0].Content != "h" || !entries[0].Truncated {
+			t.Errorf("content = %q, truncated = %v, want \"h\" truncated", entries[0].Content, entries[0].Truncated)
+		}
+	})
+
+	t.Run("invalid bytes after the sampled head", func(t *testing.T) {
+		report := strings.Repeat("<testcase/>\n", 100) + "caf\xe9\n" + strings.Repeat("<testcase/>\n", 10)
+		entries, err := ParseArtifactArchive(testArtifactZip(t, "junit.xml", report))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !entries[0].Text {
+			t.Fatal("expected the head of junit.xml to look like text")
+		}
+		if err := entries.ReadText(len(report)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if entries[0].Text || entries[0].Content != "" {
+			t.Errorf("expected junit.xml to be treated as binary, got text %v with %d bytes", entries[0].Text, len(entries[0].Content))
+		}
+	})
+
+	t.Run("invalid archive", func(t *testing.T) {
+		if _, err := ParseArtifactArchive([]byte("not a zip")); err == nil {
+			t.Error("expected error for invalid archive")
+		}
+	})
+}
@@ -0,0 +1,249 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"time"
+	"unicode/utf8"
+)
+
+// MyActionArtifactRun is the workflow run reference embedded in an artifact.
+type MyActionArtifactRun struct {
+	ID           int64  `json:"id"`
+	RepositoryID int64  `json:"repository_id"`
+	HeadSHA      string `json:"head_sha"`
+}
+
+// MyActionArtifact represents a Forgejo Actions artifact.
+type MyActionArtifact struct {
+	ID                 int64                `json:"id"`
+	Name               string               `json:"name"`
+	SizeInBytes        int64                `json:"size_in_bytes"`
+	URL                string               `json:"url"`
+	ArchiveDownloadURL string               `json:"archive_download_url"`
+	Expired            bool                 `json:"expired"`
+	WorkflowRun        *MyActionArtifactRun `json:"workflow_run"`
+	CreatedAt          time.Time            `json:"created_at"`
+	UpdatedAt          time.Time            `json:"updated_at"`
+	ExpiresAt          time.Time            `json:"expires_at"`
+}
+
+// ToMarkdown renders artifact with name, ID, size, run and expiry
+// Example: **test-reports** #12 (20480 bytes) - Run: 45 - Expires: 2024-04-14
+func (a *MyActionArtifact) ToMarkdown() string {
+	markdown := fmt.Sprintf("**%s** #%d (%d bytes)", a.Name, a.ID, a.SizeInBytes)
+	if a.WorkflowRun != nil && a.WorkflowRun.ID > 0 {
+		markdown += fmt.Sprintf(" - Run: %d", a.WorkflowRun.ID)
+	}
+	if a.Expired {
+		markdown += " `EXPIRED`"
+	} else if !a.ExpiresAt.IsZero() {
+		markdown += " - Expires: " + a.ExpiresAt.Format("2006-01-02")
+	}
+	return markdown
+}
+
+// MyActionArtifactResponse represents the response for listing artifacts.
+type MyActionArtifactResponse struct {
+	TotalCount int64               `json:"total_count"`
+	Artifacts  []*MyActionArtifact `json:"artifacts"`
+}
+
+// ActionArtifactList represents a list of artifacts response
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/actions/artifacts
+// - GET /repos/{owner}/{repo}/actions/runs/{run}/artifacts
+type ActionArtifactList struct {
+	*MyActionArtifactResponse
+}
+
+// ToMarkdown renders artifacts as a bullet list
+// Example:
+// - **test-reports** #12 (20480 bytes) - Run: 45 - Expires: 2024-04-14
+// - **coverage** #13 (1024 bytes) - Run: 45 `EXPIRED`
+func (al ActionArtifactList) ToMarkdown() string {
+	if al.MyActionArtifactResponse == nil || len(al.Artifacts) == 0 {
+		return "*No artifacts found*"
+	}
+	markdown := ""
+	for _, a := range al.Artifacts {
+		markdown += "- " + a.ToMarkdown() + "\n"
+	}
+	return markdown
+}
+
+// ArtifactEntry describes a single file inside an artifact archive.
+type ArtifactEntry struct {
+	Name string
+	Size uint64
+	// Text reports whether the entry looks like UTF-8 text.
+	Text bool
+	// Content holds the (possibly truncated) text of the entry, if it was read.
+	Content   string
+	Truncated bool
+
+	file *zip.File
+}
+
+// ArtifactEntryList represents the files of an artifact archive.
+type ArtifactEntryList []*ArtifactEntry
+
+// ParseArtifactArchive lists the entries of an artifact zip archive.
+// Directories are skipped. Each file is sniffed to decide whether it is text.
+func ParseArtifactArchive(data []byte) (ArtifactEntryList, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid artifact archive: %w", err)
+	}
+
+	ret := make(ArtifactEntryList, 0, len(r.File))
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		head, err := readZipFile(f, 512)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, &ArtifactEntry{
+			Name: f.Name,
+			Size: f.UncompressedSize64,
+			Text: isText(head),
+			file: f,
+		})
+	}
+	return ret, nil
+}
+
+// Match returns the entries whose full path or base name matches any of the
+// glob patterns (see path.Match).
+func (el ArtifactEntryList) Match(patterns []string) ArtifactEntryList {
+	var ret ArtifactEntryList
+	for _, e := range el {
+		for _, p := range patterns {
+			full, _ := path.Match(p, e.Name)
+			base, _ := path.Match(p, path.Base(e.Name))
+			if full || base {
+				ret = append(ret, e)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// ReadText loads the content of text entries, sharing a budget of maxBytes
+// among them in order. Entries which do not fit are truncated; binary entries
+// are left untouched, and so are entries turning out not to be UTF-8 past
+// their head, which are marked binary.
+func (el ArtifactEntryList) ReadText(maxBytes int) error {
+	remain := maxBytes
+	for _, e := range el {
+		if !e.Text || e.file == nil {
+			continue
+		}
+		if remain <= 0 {
+			e.Truncated = e.Size > 0
+			continue
+		}
+		data, err := readZipFile(e.file, remain)
+		if err != nil {
+			return err
+		}
+		// do not cut a multi-byte character in half; invalid bytes beyond
+		// the sampled head mean the file is not text after all
+		data = trimPartialRune(data)
+		if !utf8.Valid(data) {
+			e.Text = false
+			continue
+		}
+		e.Content = string(data)
+		e.Truncated = uint64(len(data)) < e.Size
+		remain -= len(data)
+	}
+	return nil
+}
+
+// ToMarkdown renders entries as a bullet list, followed by the content of
+// every entry that has been read
+// Example:
+// - `reports/junit.xml` (2048 bytes, text)
+// - `coverage.bin` (512 bytes, binary)
+//
+// ### reports/junit.xml
+// ```
+// <testsuite ...>
+// ```
+func (el ArtifactEntryList) ToMarkdown() string {
+	if len(el) == 0 {
+		return "*No files found in artifact*"
+	}
+	markdown := ""
+	for _, e := range el {
+		kind := "binary"
+		if e.Text {
+			kind = "text"
+		}
+		markdown += fmt.Sprintf("- `%s` (%d bytes, %s)\n", e.Name, e.Size, kind)
+	}
+	for _, e := range el {
+		if e.Content == "" && !e.Truncated {
+			continue
+		}
+		markdown += "\n### " + e.Name + "\n```\n" + e.Content
+		if e.Content != "" && e.Content[len(e.Content)-1] != '\n' {
+			markdown += "\n"
+		}
+		markdown += "```\n"
+		if e.Truncated {
+			markdown += "*Truncated: byte budget exhausted*\n"
+		}
+	}
+	return markdown
+}
+
+func readZipFile(f *zip.File, limit int) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("cannot open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, int64(limit)))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", f.Name, err)
+	}
+	return data, nil
+}
+
+// isText reports whether the leading bytes of a file look like UTF-8 text.
+func isText(head []byte) bool {
+	if bytes.IndexByte(head, 0) >= 0 {
+		return false
+	}
+	// the sample may end in the middle of a multi-byte character
+	return utf8.Valid(trimPartialRune(head))
+}
+
+// trimPartialRune drops an incomplete multi-byte character at the end of
+// data, which is left alone otherwise.
+func trimPartialRune(data []byte) []byte {
+	for i := len(data) - 1; i >= 0 && i > len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				return data[:i]
+			}
+			break
+		}
+	}
+	return data
+}
@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BulkIssueResult is the outcome of a bulk operation on one issue. Detail
+// describes a success, Err is set on failure.
+type BulkIssueResult struct {
+	Index  int64
+	Detail string
+	Err    error
+}
+
+// BulkIssueResultList holds the per-issue results of a bulk operation in
+// the order the issues were given.
+type BulkIssueResultList []*BulkIssueResult
+
+// Failed returns the number of issues the operation failed on.
+func (l BulkIssueResultList) Failed() int {
+	n := 0
+	for _, r := range l {
+		if r.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// ToMarkdown renders a summary line followed by a table of results
+// Example:
+// Succeeded on 2 of 3 issues, 1 failed
+//
+// | Issue | Result |
+// |-------|--------|
+// | #12 | ok: Fix login |
+// | #15 | **failed**: 404 Not Found |
+// | #18 | ok: Update docs |
+func (l BulkIssueResultList) ToMarkdown() string {
+	if len(l) == 0 {
+		return "*No issues matched*"
+	}
+	failed := l.Failed()
+	markdown := fmt.Sprintf("Succeeded on %d of %d issues", len(l)-failed, len(l))
+	if failed > 0 {
+		markdown += fmt.Sprintf(", %d failed", failed)
+	}
+	markdown += "\n\n| Issue | Result |\n|-------|--------|\n"
+	for _, r := range l {
+		result := "ok"
+		if r.Detail != "" {
+			result += ": " + r.Detail
+		}
+		if r.Err != nil {
+			result = "**failed**: " + r.Err.Error()
+		}
+		markdown += fmt.Sprintf("| #%d | %s |\n", r.Index, strings.ReplaceAll(result, "|", "\\|"))
+	}
+	return markdown
+}
@@ -0,0 +1,51 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBulkIssueResultList_ToMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		results  BulkIssueResultList
+		required []string
+	}{
+		{
+			name: "mixed results",
+			results: BulkIssueResultList{
+				{Index: 12, Detail: "Fix login"},
+				{Index: 15, Err: errors.New("404 Not Found")},
+				{Index: 18},
+			},
+			required: []string{
+				"Succeeded on 2 of 3 issues, 1 failed",
+				"| #12 | ok: Fix login |",
+				"| #15 | **failed**: 404 Not Found |",
+				"| #18 | ok |",
+			},
+		},
+		{
+			name:     "pipes are escaped",
+			results:  BulkIssueResultList{{Index: 1, Detail: "a | b"}},
+			required: []string{"Succeeded on 1 of 1 issues\n", `| #1 | ok: a \| b |`},
+		},
+		{
+			name:     "empty",
+			results:  BulkIssueResultList{},
+			required: []string{"*No issues matched*"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertContains(t, tt.results.ToMarkdown(), tt.required)
+		})
+	}
+}
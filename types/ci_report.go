@@ -0,0 +1,234 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// CIReportRow holds the statistics of one workflow on one branch. Runs only
+// counts finished runs; the success rate ignores cancelled and skipped runs.
+type CIReportRow struct {
+	Workflow  string
+	Branch    string
+	Runs      int
+	Success   int
+	Failure   int
+	Cancelled int
+	Median    time.Duration
+	P95       time.Duration
+}
+
+// SuccessRate returns the percentage of successful runs among the runs that
+// either succeeded or failed, or -1 if there are none.
+func (r *CIReportRow) SuccessRate() float64 {
+	if r.Success+r.Failure == 0 {
+		return -1
+	}
+	return float64(r.Success) * 100 / float64(r.Success+r.Failure)
+}
+
+// FlakyJob is a job which both succeeded and failed on the same commit.
+type FlakyJob struct {
+	Workflow  string
+	Job       string
+	SHA       string
+	Successes int
+	Failures  int
+}
+
+// CIReport summarizes the Actions history of a repository in a time window.
+type CIReport struct {
+	Since time.Time
+	Until time.Time
+	Rows  []*CIReportRow
+	Flaky []*FlakyJob
+}
+
+// BuildCIReport computes per workflow and branch statistics from the tasks
+// created in [since, until). Tasks of the same run are merged, so durations
+// are measured from the first job start to the last job update.
+func BuildCIReport(tasks []*MyActionTask, since, until time.Time) *CIReport {
+	type runKey struct {
+		workflow string
+		number   int64
+	}
+	type jobKey struct {
+		workflow, job, sha string
+	}
+
+	runs := map[runKey]ActionTaskGroup{}
+	jobs := map[jobKey]*FlakyJob{}
+	for _, t := range tasks {
+		if t.CreatedAt.Before(since) || !t.CreatedAt.Before(until) {
+			continue
+		}
+		k := runKey{t.WorkflowID, t.RunNumber}
+		runs[k] = append(runs[k], t)
+
+		if t.HeadSHA == "" || (t.Status != "success" && t.Status != "failure") {
+			continue
+		}
+		jk := jobKey{t.WorkflowID, t.Name, t.HeadSHA}
+		job, ok := jobs[jk]
+		if !ok {
+			job = &FlakyJob{Workflow: t.WorkflowID, Job: t.Name, SHA: t.HeadSHA}
+			jobs[jk] = job
+		}
+		if t.Status == "success" {
+			job.Successes++
+		} else {
+			job.Failures++
+		}
+	}
+
+	type rowKey struct{ workflow, branch string }
+	rows := map[rowKey]*CIReportRow{}
+	durations := map[rowKey][]time.Duration{}
+	for k, group := range runs {
+		if !group.Finished() {
+			continue
+		}
+		rk := rowKey{k.workflow, group[0].HeadBranch}
+		row, ok := rows[rk]
+		if !ok {
+			row = &CIReportRow{Workflow: rk.workflow, Branch: rk.branch}
+			rows[rk] = row
+		}
+		row.Runs++
+		switch group.Status() {
+		case "success":
+			row.Success++
+		case "failure":
+			row.Failure++
+		case "cancelled":
+			row.Cancelled++
+		}
+		if d, ok := group.duration(); ok {
+			durations[rk] = append(durations[rk], d)
+		}
+	}
+
+	report := &CIReport{Since: since, Until: until}
+	for rk, row := range rows {
+		d := durations[rk]
+		slices.Sort(d)
+		row.Median = median(d)
+		row.P95 = percentile(d, 95)
+		report.Rows = append(report.Rows, row)
+	}
+	slices.SortFunc(report.Rows, func(a, b *CIReportRow) int {
+		return cmp.Or(cmp.Compare(a.Workflow, b.Workflow), cmp.Compare(a.Branch, b.Branch))
+	})
+
+	for _, job := range jobs {
+		if job.Successes > 0 && job.Failures > 0 {
+			report.Flaky = append(report.Flaky, job)
+		}
+	}
+	slices.SortFunc(report.Flaky, func(a, b *FlakyJob) int {
+		return cmp.Or(
+			cmp.Compare(b.Failures, a.Failures),
+			cmp.Compare(a.Workflow, b.Workflow),
+			cmp.Compare(a.Job, b.Job),
+			cmp.Compare(a.SHA, b.SHA),
+		)
+	})
+
+	return report
+}
+
+// duration returns the time from the first job start to the last job update.
+func (g ActionTaskGroup) duration() (time.Duration, bool) {
+	var start, end time.Time
+	for _, t := range g {
+		if t.RunStartedAt.IsZero() || t.UpdatedAt.IsZero() {
+			continue
+		}
+		if start.IsZero() || t.RunStartedAt.Before(start) {
+			start = t.RunStartedAt
+		}
+		if t.UpdatedAt.After(end) {
+			end = t.UpdatedAt
+		}
+	}
+	if start.IsZero() || end.Before(start) {
+		return 0, false
+	}
+	return end.Sub(start), true
+}
+
+// median of sorted durations, 0 if empty.
+func median(sorted []time.Duration) time.Duration {
+	n := len(sorted)
+	if n == 0 {
+		return 0
+	}
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// percentile of sorted durations using the nearest-rank method, 0 if empty.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// ToMarkdown renders the statistics table followed by flaky jobs
+// Example:
+// ## CI report 2024-01-08 – 2024-01-15
+//
+// | Workflow | Branch | Runs | Success rate | Failed | Cancelled | Median | P95 |
+// |----------|--------|------|--------------|--------|-----------|--------|-----|
+// | ci.yml | main | 20 | 90.0% | 2 | 0 | 4m10s | 6m2s |
+//
+// ### Flaky jobs (success and failure on the same commit)
+// | Workflow | Job | Commit | Successes | Failures |
+// |----------|-----|--------|-----------|----------|
+// | ci.yml | test | a1b2c3d4 | 1 | 1 |
+func (r *CIReport) ToMarkdown() string {
+	markdown := fmt.Sprintf("## CI report %s – %s\n\n", r.Since.Format("2006-01-02"), r.Until.Format("2006-01-02"))
+	if len(r.Rows) == 0 {
+		markdown += "*No finished runs in this period*\n"
+	} else {
+		markdown += "| Workflow | Branch | Runs | Success rate | Failed | Cancelled | Median | P95 |\n"
+		markdown += "|----------|--------|------|--------------|--------|-----------|--------|-----|\n"
+		for _, row := range r.Rows {
+			rate := "-"
+			if v := row.SuccessRate(); v >= 0 {
+				rate = fmt.Sprintf("%.1f%%", v)
+			}
+			markdown += fmt.Sprintf("| %s | %s | %d | %s | %d | %d | %s | %s |\n",
+				row.Workflow, row.Branch, row.Runs, rate, row.Failure, row.Cancelled,
+				row.Median.Round(time.Second), row.P95.Round(time.Second))
+		}
+	}
+
+	markdown += "\n### Flaky jobs (success and failure on the same commit)\n"
+	if len(r.Flaky) == 0 {
+		return markdown + "*None*\n"
+	}
+	markdown += "| Workflow | Job | Commit | Successes | Failures |\n"
+	markdown += "|----------|-----|--------|-----------|----------|\n"
+	for _, job := range r.Flaky {
+		sha := job.SHA
+		if len(sha) > 8 {
+			sha = sha[:8]
+		}
+		markdown += fmt.Sprintf("| %s | %s | %s | %d | %d |\n", job.Workflow, job.Job, sha, job.Successes, job.Failures)
+	}
+	return markdown
+}
@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildCIReport(t *testing.T) {
+	base := testTime()
+	task := func(run int64, job, branch, sha, status string, minutes int) *MyActionTask {
+		start := base.Add(time.Duration(run) * time.Hour)
+		return &MyActionTask{
+			Name: job, WorkflowID: "ci.yml", RunNumber: run, HeadBranch: branch, HeadSHA: sha, Status: status,
+			CreatedAt: start, RunStartedAt: start, UpdatedAt: start.Add(time.Duration(minutes) * time.Minute),
+		}
+	}
+	tasks := []*MyActionTask{
+		task(1, "test", "main", "aaaa1111bbbb", "success", 2),
+		task(2, "test", "main", "cccc2222", "failure", 4),
+		task(3, "test", "main", "cccc2222", "success", 6),
+		task(4, "test", "main", "dddd3333", "success", 8),
+		task(5, "test", "main", "eeee4444", "running", 1),
+		task(6, "test", "dev", "ffff5555", "cancelled", 1),
+		task(-100, "test", "main", "old", "failure", 1),
+	}
+
+	report := BuildCIReport(tasks, base, base.Add(24*time.Hour))
+	if len(report.Rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(report.Rows))
+	}
+
+	main := report.Rows[1]
+	if main.Branch != "main" || main.Runs != 4 || main.Success != 3 || main.Failure != 1 {
+		t.Errorf("unexpected main row: %+v", main)
+	}
+	if main.SuccessRate() != 75 {
+		t.Errorf("expected 75%% success rate, got %v", main.SuccessRate())
+	}
+	if main.Median != 5*time.Minute || main.P95 != 8*time.Minute {
+		t.Errorf("expected median 5m and p95 8m, got %s and %s", main.Median, main.P95)
+	}
+	if dev := report.Rows[0]; dev.Cancelled != 1 || dev.SuccessRate() != -1 {
+		t.Errorf("unexpected dev row: %+v", dev)
+	}
+
+	if len(report.Flaky) != 1 || report.Flaky[0].SHA != "cccc2222" {
+		t.Fatalf("expected one flaky job on cccc2222, got %+v", report.Flaky)
+	}
+
+	assertContains(t, report.ToMarkdown(), []string{
+		"## CI report 2024-01-15 – 2024-01-16",
+		"| ci.yml | main | 4 | 75.0% | 1 | 0 | 5m0s | 8m0s |",
+		"| ci.yml | dev | 1 | - | 0 | 1 | 1m0s | 1m0s |",
+		"| ci.yml | test | cccc2222 | 1 | 1 |",
+	})
+}
+
+func TestCIReport_ToMarkdown_Empty(t *testing.T) {
+	report := BuildCIReport(nil, testTime(), testTime().Add(time.Hour))
+	assertContains(t, report.ToMarkdown(), []string{"No finished runs in this period", "*None*"})
+}
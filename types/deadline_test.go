@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+func TestBuildDeadlineReport(t *testing.T) {
+	now := testTime()
+	due := func(days int) *time.Time {
+		d := now.AddDate(0, 0, days)
+		return &d
+	}
+	alice := &forgejo.User{UserName: "alice"}
+	bob := &forgejo.User{UserName: "bob"}
+
+	report := BuildDeadlineReport(map[string][]*forgejo.Issue{
+		"org/project": {
+			{Index: 1, Title: "Overdue", State: forgejo.StateOpen, Deadline: due(-5), Assignees: []*forgejo.User{alice}, Milestone: testMilestone(), HTMLURL: "https://example.com/org/project/issues/1"},
+			{Index: 2, Title: "Soon", State: forgejo.StateOpen, Deadline: due(3), Assignees: []*forgejo.User{alice, bob}},
+			{Index: 3, Title: "Later", State: forgejo.StateOpen, Deadline: due(30), Assignees: []*forgejo.User{bob}},
+			{Index: 4, Title: "Closed", State: forgejo.StateClosed, Deadline: due(-1)},
+			{Index: 5, Title: "No deadline", State: forgejo.StateOpen},
+		},
+		"org/backend": {
+			{Index: 9, Title: "Nobody", State: forgejo.StateOpen, Deadline: due(1)},
+		},
+	}, now, 7)
+
+	if report.Overdue != 1 || report.DueSoon != 2 {
+		t.Errorf("Overdue=%d DueSoon=%d, want 1 and 2", report.Overdue, report.DueSoon)
+	}
+	var keys []string
+	for _, g := range report.ByAssignee {
+		keys = append(keys, g.Key)
+	}
+	if strings.Join(keys, ",") != "@alice,(unassigned),@bob" {
+		t.Errorf("assignee order = %v", keys)
+	}
+
+	output := report.ToMarkdown()
+	assertContains(t, output, []string{
+		"## Deadlines as of 2024-01-15 (due within 7 days)",
+		"Overdue: 1, due soon: 2",
+		"| @alice | 1 | 1 |",
+		"| @bob | 0 | 1 |",
+		"- **overdue** 2024-01-10 (5 days late) [org/project#1](https://example.com/org/project/issues/1) Overdue",
+		"- due 2024-01-16 (in 1 day) org/backend#9 Nobody",
+		"### By milestone",
+		"| (no milestone) | 0 | 2 |",
+	})
+	if strings.Contains(output, "Later") || strings.Contains(output, "Closed") {
+		t.Errorf("report contains issues outside the window:\n%s", output)
+	}
+
+	onlyMilestone := report.ToMarkdown("milestone")
+	if strings.Contains(onlyMilestone, "### By assignee") {
+		t.Error("ToMarkdown(\"milestone\") rendered the assignee grouping")
+	}
+}
+
+func TestDeadlineReport_Empty(t *testing.T) {
+	report := BuildDeadlineReport(nil, testTime(), 7)
+	assertContains(t, report.ToMarkdown(), []string{"*No overdue or due-soon issues*"})
+}
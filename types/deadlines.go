@@ -0,0 +1,220 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+// DeadlineGroups are the supported groupings of a deadline report.
+var DeadlineGroups = []string{"assignee", "milestone"}
+
+// DeadlineIssue is an open issue with a due date, and the repository it
+// belongs to.
+type DeadlineIssue struct {
+	Repo    string
+	Issue   *forgejo.Issue
+	Overdue bool
+}
+
+// DeadlineGroup collects the overdue and due-soon issues of one assignee or
+// milestone.
+type DeadlineGroup struct {
+	Key     string
+	Overdue int
+	DueSoon int
+	Issues  []*DeadlineIssue
+}
+
+// DeadlineReport lists the open issues which are overdue or due within a
+// number of days, grouped by assignee and by milestone.
+type DeadlineReport struct {
+	Now         time.Time
+	Days        int
+	Overdue     int
+	DueSoon     int
+	ByAssignee  []*DeadlineGroup
+	ByMilestone []*DeadlineGroup
+}
+
+// BuildDeadlineReport selects the open issues of one or more repositories,
+// keyed by repository full name, that are overdue at now or due within days.
+// Issues are sorted by due date; an issue with several assignees is listed
+// under each of them.
+func BuildDeadlineReport(byRepo map[string][]*forgejo.Issue, now time.Time, days int) *DeadlineReport {
+	report := &DeadlineReport{Now: now, Days: days}
+	limit := now.AddDate(0, 0, days)
+
+	var selected []*DeadlineIssue
+	for repo, issues := range byRepo {
+		for _, issue := range issues {
+			if issue.Deadline == nil || issue.Deadline.IsZero() || issue.State == forgejo.StateClosed || issue.Deadline.After(limit) {
+				continue
+			}
+			d := &DeadlineIssue{Repo: repo, Issue: issue, Overdue: issue.Deadline.Before(now)}
+			if d.Overdue {
+				report.Overdue++
+			} else {
+				report.DueSoon++
+			}
+			selected = append(selected, d)
+		}
+	}
+	slices.SortFunc(selected, func(a, b *DeadlineIssue) int {
+		return cmp.Or(
+			a.Issue.Deadline.Compare(*b.Issue.Deadline),
+			cmp.Compare(a.Repo, b.Repo),
+			cmp.Compare(a.Issue.Index, b.Issue.Index),
+		)
+	})
+
+	assignees := map[string]*DeadlineGroup{}
+	milestones := map[string]*DeadlineGroup{}
+	for _, d := range selected {
+		var keys []string
+		for _, u := range d.Issue.Assignees {
+			keys = append(keys, "@"+u.UserName)
+		}
+		if len(keys) == 0 {
+			keys = []string{"(unassigned)"}
+		}
+		for _, key := range keys {
+			report.ByAssignee = addToDeadlineGroup(report.ByAssignee, assignees, key, d)
+		}
+
+		key := "(no milestone)"
+		if d.Issue.Milestone != nil {
+			key = d.Issue.Milestone.Title
+		}
+		report.ByMilestone = addToDeadlineGroup(report.ByMilestone, milestones, key, d)
+	}
+
+	byLoad := func(a, b *DeadlineGroup) int {
+		return cmp.Or(
+			cmp.Compare(b.Overdue, a.Overdue),
+			cmp.Compare(b.DueSoon, a.DueSoon),
+			cmp.Compare(a.Key, b.Key),
+		)
+	}
+	slices.SortFunc(report.ByAssignee, byLoad)
+	slices.SortFunc(report.ByMilestone, byLoad)
+	return report
+}
+
+func addToDeadlineGroup(groups []*DeadlineGroup, index map[string]*DeadlineGroup, key string, d *DeadlineIssue) []*DeadlineGroup {
+	g, ok := index[key]
+	if !ok {
+		g = &DeadlineGroup{Key: key}
+		index[key] = g
+		groups = append(groups, g)
+	}
+	if d.Overdue {
+		g.Overdue++
+	} else {
+		g.DueSoon++
+	}
+	g.Issues = append(g.Issues, d)
+	return groups
+}
+
+// markdown renders the issue as a list item with due date and link
+// Example: - **overdue** 2024-01-10 (5 days late) [org/project#12](https://...) Fix login
+func (d *DeadlineIssue) markdown(now time.Time) string {
+	due := d.Issue.Deadline.Format("2006-01-02")
+	days := calendarDays(now, *d.Issue.Deadline)
+	ref := fmt.Sprintf("%s#%d", d.Repo, d.Issue.Index)
+	if d.Issue.HTMLURL != "" {
+		ref = fmt.Sprintf("[%s](%s)", ref, d.Issue.HTMLURL)
+	}
+
+	var when string
+	switch {
+	case d.Overdue && days <= -1:
+		when = fmt.Sprintf("**overdue** %s (%s late)", due, dayCount(-days))
+	case d.Overdue:
+		when = fmt.Sprintf("**overdue** %s (today)", due)
+	case days == 0:
+		when = fmt.Sprintf("due %s (today)", due)
+	default:
+		when = fmt.Sprintf("due %s (in %s)", due, dayCount(days))
+	}
+	return fmt.Sprintf("- %s %s %s\n", when, ref, d.Issue.Title)
+}
+
+// calendarDays counts the days from one date to another in the time zone
+// of from, ignoring the time of day.
+func calendarDays(from, to time.Time) int {
+	date := func(t time.Time) time.Time {
+		y, m, d := t.In(from.Location()).Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	return int(date(to).Sub(date(from)).Hours() / 24)
+}
+
+func dayCount(n int) string {
+	if n == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", n)
+}
+
+// ToMarkdown renders the selected groupings, each as a count table followed
+// by the issues of every group. groupBy defaults to all DeadlineGroups.
+// Example:
+// ## Deadlines as of 2024-01-15 (due within 7 days)
+//
+// Overdue: 1, due soon: 1
+//
+// ### By assignee
+// | Assignee | Overdue | Due soon |
+// |----------|---------|----------|
+// | @alice | 1 | 1 |
+//
+// #### @alice
+// - **overdue** 2024-01-10 (5 days late) [org/project#12](https://...) Fix login
+// - due 2024-01-18 (in 3 days) [org/project#15](https://...) Update docs
+func (r *DeadlineReport) ToMarkdown(groupBy ...string) string {
+	markdown := fmt.Sprintf("## Deadlines as of %s (due within %d days)\n\n", r.Now.Format("2006-01-02"), r.Days)
+	if r.Overdue+r.DueSoon == 0 {
+		return markdown + "*No overdue or due-soon issues*\n"
+	}
+	markdown += fmt.Sprintf("Overdue: %d, due soon: %d\n", r.Overdue, r.DueSoon)
+
+	if len(groupBy) == 0 {
+		groupBy = DeadlineGroups
+	}
+	for _, by := range groupBy {
+		switch by {
+		case "assignee":
+			markdown += r.groupMarkdown(by, "Assignee", r.ByAssignee)
+		case "milestone":
+			markdown += r.groupMarkdown(by, "Milestone", r.ByMilestone)
+		}
+	}
+	return markdown
+}
+
+func (r *DeadlineReport) groupMarkdown(by, header string, groups []*DeadlineGroup) string {
+	markdown := fmt.Sprintf("\n### By %s\n", by)
+	markdown += fmt.Sprintf("| %s | Overdue | Due soon |\n", header)
+	markdown += "|----------|---------|----------|\n"
+	for _, g := range groups {
+		markdown += fmt.Sprintf("| %s | %d | %d |\n", g.Key, g.Overdue, g.DueSoon)
+	}
+	for _, g := range groups {
+		markdown += "\n#### " + g.Key + "\n"
+		for _, d := range g.Issues {
+			markdown += d.markdown(r.Now)
+		}
+	}
+	return markdown
+}
@@ -8,6 +8,9 @@ package types
 
 import (
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
 )
@@ -20,6 +23,70 @@ type MyIssueMeta struct {
 	Name  string `json:"repo,omitempty"`
 }
 
+// ParseIssueRef parses an issue reference: a bare number ("123" or "#123"),
+// a repository in the same owner ("repo#123"), a fully qualified reference
+// ("owner/repo#123") or an issue or pull request URL. Missing parts are taken
+// from owner and repo.
+func ParseIssueRef(ref, owner, repo string) (MyIssueMeta, error) {
+	ref = strings.TrimSpace(ref)
+	invalid := fmt.Errorf("invalid issue reference '%s', expected 123, owner/repo#123 or an issue URL", ref)
+
+	if strings.Contains(ref, "://") {
+		u, err := url.Parse(ref)
+		if err != nil {
+			return MyIssueMeta{}, invalid
+		}
+		// the instance may live in a sub-path, so match from the end:
+		// .../{owner}/{repo}/issues/{index}
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		n := len(parts)
+		if n < 4 || (parts[n-2] != "issues" && parts[n-2] != "pulls") {
+			return MyIssueMeta{}, invalid
+		}
+		index, err := strconv.ParseInt(parts[n-1], 10, 64)
+		if err != nil || index <= 0 {
+			return MyIssueMeta{}, invalid
+		}
+		return MyIssueMeta{Owner: parts[n-4], Name: parts[n-3], Index: index}, nil
+	}
+
+	path, number, found := strings.Cut(ref, "#")
+	if !found {
+		number, path = path, ""
+	}
+	index, err := strconv.ParseInt(number, 10, 64)
+	if err != nil || index <= 0 {
+		return MyIssueMeta{}, invalid
+	}
+	meta := MyIssueMeta{Owner: owner, Name: repo, Index: index}
+	switch o, r, qualified := strings.Cut(path, "/"); {
+	case path == "":
+	case qualified && o != "" && r != "" && !strings.Contains(r, "/"):
+		meta.Owner, meta.Name = o, r
+	case !qualified:
+		meta.Name = path
+	default:
+		return MyIssueMeta{}, invalid
+	}
+	return meta, nil
+}
+
+// Ref renders the issue as owner/repo#index, or just #index when it is in
+// the repository owner/repo.
+func (m MyIssueMeta) Ref(owner, repo string) string {
+	if m.Owner == owner && m.Name == repo {
+		return fmt.Sprintf("#%d", m.Index)
+	}
+	return fmt.Sprintf("%s/%s#%d", m.Owner, m.Name, m.Index)
+}
+
+// linkedIssueMarkdown renders a linked issue, prefixed by its repository
+// when the API returned it.
+// Example: org/backend#123 **Fix authentication bug** (open)
+func linkedIssueMarkdown(issue *forgejo.Issue) string {
+	return fmt.Sprintf("%s **%s** (%s)\n", IssueKey(IssueRepo(issue, ""), issue.Index), issue.Title, issue.State)
+}
+
 // IssueDependencyList represents a list of issues that block the current issue.
 // According to Forgejo API definition, these are issues that must be closed
 // before the current issue can be closed.
@@ -27,10 +94,10 @@ type MyIssueMeta struct {
 type IssueDependencyList []*forgejo.Issue
 
 // ToMarkdown renders issue dependencies with essential information for quick scanning
-// Shows: #Index **Title** (state)
+// Shows: owner/repo#Index **Title** (state)
 // Example per issue:
-// #123 **Fix authentication bug** (open)
-// #45 **Update user model** (closed)
+// org/backend#123 **Fix authentication bug** (open)
+// org/frontend#45 **Update user model** (closed)
 func (idl IssueDependencyList) ToMarkdown() string {
 	if len(idl) == 0 {
 		return "*No issue dependencies found*"
@@ -41,7 +108,7 @@ func (idl IssueDependencyList) ToMarkdown() string {
 		if issue == nil {
 			continue
 		}
-		markdown += fmt.Sprintf("#%d **%s** (%s)\n", issue.Index, issue.Title, issue.State)
+		markdown += linkedIssueMarkdown(issue)
 	}
 
 	return markdown
@@ -54,10 +121,10 @@ func (idl IssueDependencyList) ToMarkdown() string {
 type IssueBlockingList []*forgejo.Issue
 
 // ToMarkdown renders issue blocking list with essential information for quick scanning
-// Shows: #Index **Title** (state)
+// Shows: owner/repo#Index **Title** (state)
 // Example per issue:
-// #123 **Fix authentication bug** (open)
-// #45 **Update user model** (closed)
+// org/backend#123 **Fix authentication bug** (open)
+// org/frontend#45 **Update user model** (closed)
 func (ibl IssueBlockingList) ToMarkdown() string {
 	if len(ibl) == 0 {
 		return "*This issue is not blocking any other issues*"
@@ -68,7 +135,7 @@ func (ibl IssueBlockingList) ToMarkdown() string {
 		if issue == nil {
 			continue
 		}
-		markdown += fmt.Sprintf("#%d **%s** (%s)\n", issue.Index, issue.Title, issue.State)
+		markdown += linkedIssueMarkdown(issue)
 	}
 
 	return markdown
@@ -27,12 +27,13 @@ func TestIssueDependencyList_ToMarkdown(t *testing.T) {
 					State: "open",
 				},
 				&forgejo.Issue{
-					Index: 45,
-					Title: "Update user model",
-					State: "closed",
+					Index:      45,
+					Title:      "Update user model",
+					State:      "closed",
+					Repository: &forgejo.RepositoryMeta{Owner: "org", Name: "backend"},
 				},
 			},
-			required: []string{"#123", "**Fix authentication bug**", "(open)", "#45", "**Update user model**", "(closed)"},
+			required: []string{"#123", "**Fix authentication bug**", "(open)", "org/backend#45 **Update user model** (closed)"},
 		},
 		{
 			name:         "empty dependency list",
@@ -49,6 +50,37 @@ func TestIssueDependencyList_ToMarkdown(t *testing.T) {
 	}
 }
 
+func TestParseIssueRef(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want MyIssueMeta
+	}{
+		{"123", MyIssueMeta{Owner: "org", Name: "web", Index: 123}},
+		{"#123", MyIssueMeta{Owner: "org", Name: "web", Index: 123}},
+		{"api#7", MyIssueMeta{Owner: "org", Name: "api", Index: 7}},
+		{"other/api#7", MyIssueMeta{Owner: "other", Name: "api", Index: 7}},
+		{"https://git.example.com/other/api/issues/7", MyIssueMeta{Owner: "other", Name: "api", Index: 7}},
+		{"https://example.com/git/other/api/pulls/8#issuecomment-1", MyIssueMeta{Owner: "other", Name: "api", Index: 8}},
+	}
+	for _, tt := range tests {
+		got, err := ParseIssueRef(tt.ref, "org", "web")
+		if err != nil || got != tt.want {
+			t.Errorf("ParseIssueRef(%q) = %+v, %v; want %+v", tt.ref, got, err, tt.want)
+		}
+	}
+
+	for _, ref := range []string{"", "abc", "#0", "a/b/c#1", "/api#1", "https://example.com/org/api/wiki/7"} {
+		if _, err := ParseIssueRef(ref, "org", "web"); err == nil {
+			t.Errorf("ParseIssueRef(%q) succeeded, want error", ref)
+		}
+	}
+
+	meta := MyIssueMeta{Owner: "org", Name: "api", Index: 7}
+	if meta.Ref("org", "api") != "#7" || meta.Ref("org", "web") != "org/api#7" {
+		t.Errorf("Ref() = %q, %q", meta.Ref("org", "api"), meta.Ref("org", "web"))
+	}
+}
+
 func TestIssueBlockingList_ToMarkdown(t *testing.T) {
 	tests := []struct {
 		name     string
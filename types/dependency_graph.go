@@ -0,0 +1,343 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+// IssueKey identifies an issue across repositories as owner/repo#index.
+func IssueKey(repo string, index int64) string {
+	return fmt.Sprintf("%s#%d", repo, index)
+}
+
+// IssueRepo returns the owner/repo of an issue, or fallback if the API did
+// not include the repository.
+func IssueRepo(issue *forgejo.Issue, fallback string) string {
+	if issue.Repository != nil && issue.Repository.Owner != "" && issue.Repository.Name != "" {
+		return issue.Repository.Owner + "/" + issue.Repository.Name
+	}
+	return fallback
+}
+
+// DependencyNode is an issue of a dependency graph.
+type DependencyNode struct {
+	Key   string
+	Repo  string
+	Issue *forgejo.Issue
+	// DependsOn holds the keys of the issues which must be closed first.
+	DependsOn []string
+}
+
+// Open reports whether the issue still needs to be done.
+func (n *DependencyNode) Open() bool {
+	return n.Issue.State != forgejo.StateClosed
+}
+
+// DependencyGraph is a set of issues linked by dependencies, possibly
+// spanning several repositories.
+type DependencyGraph struct {
+	Nodes map[string]*DependencyNode
+	// Keys lists the nodes in the order they were added.
+	Keys []string
+	// Truncated is set when the traversal stopped at its size limit.
+	Truncated bool
+}
+
+// NewDependencyGraph creates an empty graph.
+func NewDependencyGraph() *DependencyGraph {
+	return &DependencyGraph{Nodes: map[string]*DependencyNode{}}
+}
+
+// AddIssue adds an issue of repo unless it is already in the graph, and
+// returns its node.
+func (g *DependencyGraph) AddIssue(repo string, issue *forgejo.Issue) *DependencyNode {
+	key := IssueKey(repo, issue.Index)
+	if n, ok := g.Nodes[key]; ok {
+		return n
+	}
+	n := &DependencyNode{Key: key, Repo: repo, Issue: issue}
+	g.Nodes[key] = n
+	g.Keys = append(g.Keys, key)
+	return n
+}
+
+// AddDependency records that the issue from cannot be closed before the
+// issue to. Both must be in the graph.
+func (g *DependencyGraph) AddDependency(from, to string) {
+	n := g.Nodes[from]
+	if n != nil && g.Nodes[to] != nil && !slices.Contains(n.DependsOn, to) {
+		n.DependsOn = append(n.DependsOn, to)
+	}
+}
+
+// Links returns the number of dependencies in the graph.
+func (g *DependencyGraph) Links() int {
+	count := 0
+	for _, n := range g.Nodes {
+		count += len(n.DependsOn)
+	}
+	return count
+}
+
+// Cycles returns the strongly connected components that form cycles, each
+// listed in insertion order. The order is not a path: with three or more
+// issues a group may hold several cycles, see CycleLinks for its edges.
+func (g *DependencyGraph) Cycles() [][]string {
+	// Tarjan's algorithm
+	var (
+		index   = map[string]int{}
+		low     = map[string]int{}
+		onStack = map[string]bool{}
+		stack   []string
+		next    int
+		cycles  [][]string
+	)
+	var visit func(key string)
+	visit = func(key string) {
+		index[key], low[key] = next, next
+		next++
+		stack = append(stack, key)
+		onStack[key] = true
+
+		for _, dep := range g.Nodes[key].DependsOn {
+			if _, seen := index[dep]; !seen {
+				visit(dep)
+				low[key] = min(low[key], low[dep])
+			} else if onStack[dep] {
+				low[key] = min(low[key], index[dep])
+			}
+		}
+
+		if low[key] != index[key] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == key {
+				break
+			}
+		}
+		if len(component) > 1 || slices.Contains(g.Nodes[key].DependsOn, key) {
+			slices.SortFunc(component, func(a, b string) int {
+				return slices.Index(g.Keys, a) - slices.Index(g.Keys, b)
+			})
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, key := range g.Keys {
+		if _, seen := index[key]; !seen {
+			visit(key)
+		}
+	}
+	slices.SortFunc(cycles, func(a, b []string) int {
+		return slices.Index(g.Keys, a[0]) - slices.Index(g.Keys, b[0])
+	})
+	return cycles
+}
+
+// CycleLinks returns the dependencies between the issues of a cycle group as
+// pairs of an issue and the issue it depends on, in insertion order.
+func (g *DependencyGraph) CycleLinks(group []string) [][2]string {
+	var links [][2]string
+	for _, key := range group {
+		for _, dep := range g.Nodes[key].DependsOn {
+			if slices.Contains(group, dep) {
+				links = append(links, [2]string{key, dep})
+			}
+		}
+	}
+	return links
+}
+
+// Stages orders the open issues topologically: the first stage can start
+// now since all its dependencies are closed, each later stage only depends
+// on earlier ones. Open issues in or behind a cycle are returned separately
+// as stuck.
+func (g *DependencyGraph) Stages() (stages [][]*DependencyNode, stuck []*DependencyNode) {
+	pending := map[string]int{}
+	for _, key := range g.Keys {
+		n := g.Nodes[key]
+		if !n.Open() {
+			continue
+		}
+		pending[key] = 0
+		for _, dep := range n.DependsOn {
+			if g.Nodes[dep].Open() {
+				pending[key]++
+			}
+		}
+	}
+
+	done := map[string]bool{}
+	for len(done) < len(pending) {
+		var stage []*DependencyNode
+		for _, key := range g.Keys {
+			if count, ok := pending[key]; ok && count == 0 && !done[key] {
+				stage = append(stage, g.Nodes[key])
+			}
+		}
+		if len(stage) == 0 {
+			break
+		}
+		for _, n := range stage {
+			done[n.Key] = true
+		}
+		for _, key := range g.Keys {
+			if _, ok := pending[key]; !ok || done[key] {
+				continue
+			}
+			for _, dep := range g.Nodes[key].DependsOn {
+				if slices.ContainsFunc(stage, func(n *DependencyNode) bool { return n.Key == dep }) {
+					pending[key]--
+				}
+			}
+		}
+		stages = append(stages, stage)
+	}
+
+	for _, key := range g.Keys {
+		if _, ok := pending[key]; ok && !done[key] {
+			stuck = append(stuck, g.Nodes[key])
+		}
+	}
+	return stages, stuck
+}
+
+// label renders a node as owner/repo#index and title, dropping the repo
+// when it is the only one in the graph.
+func (g *DependencyGraph) label(n *DependencyNode, singleRepo bool) string {
+	if singleRepo {
+		return fmt.Sprintf("#%d %s", n.Issue.Index, n.Issue.Title)
+	}
+	return n.Key + " " + n.Issue.Title
+}
+
+func (g *DependencyGraph) singleRepo() bool {
+	for _, n := range g.Nodes {
+		if n.Repo != g.Nodes[g.Keys[0]].Repo {
+			return false
+		}
+	}
+	return true
+}
+
+// Mermaid renders the graph as a flowchart where an arrow points from an
+// issue to the issues waiting for it. Closed issues are greyed out.
+// Example:
+// flowchart TD
+//
+//	n0["#12 Fix login"]
+//	n1["#10 Add session store"]
+//	n1 --> n0
+//	class n1 closed
+//	classDef closed fill:#eee,stroke:#999,color:#999
+func (g *DependencyGraph) Mermaid() string {
+	if len(g.Keys) == 0 {
+		return "flowchart TD\n"
+	}
+	single := g.singleRepo()
+	ids := map[string]string{}
+	markdown := "flowchart TD\n"
+	for i, key := range g.Keys {
+		ids[key] = fmt.Sprintf("n%d", i)
+		label := strings.ReplaceAll(g.label(g.Nodes[key], single), `"`, "#quot;")
+		markdown += fmt.Sprintf("    %s[\"%s\"]\n", ids[key], label)
+	}
+	var closed []string
+	for _, key := range g.Keys {
+		n := g.Nodes[key]
+		for _, dep := range n.DependsOn {
+			markdown += fmt.Sprintf("    %s --> %s\n", ids[dep], ids[key])
+		}
+		if !n.Open() {
+			closed = append(closed, ids[key])
+		}
+	}
+	if len(closed) > 0 {
+		markdown += "    class " + strings.Join(closed, ",") + " closed\n"
+		markdown += "    classDef closed fill:#eee,stroke:#999,color:#999\n"
+	}
+	return markdown
+}
+
+// ToMarkdown renders the Mermaid flowchart, the cycles and the order in
+// which the open issues can be worked on
+// Example:
+// ## Dependency graph: 3 issues, 2 links
+//
+// ```mermaid
+// flowchart TD
+// ...
+// ```
+//
+// ### Can start now
+// - #10 Add session store
+//
+// ### Next
+// 2. #12 Fix login
+func (g *DependencyGraph) ToMarkdown() string {
+	if len(g.Keys) == 0 {
+		return "*No issues found*"
+	}
+	markdown := fmt.Sprintf("## Dependency graph: %d issues, %d links\n\n", len(g.Keys), g.Links())
+	if g.Truncated {
+		markdown += "*The graph was cut off at its size limit, some dependencies are missing.*\n\n"
+	}
+	markdown += "```mermaid\n" + g.Mermaid() + "```\n"
+
+	single := g.singleRepo()
+	cycles := g.Cycles()
+	if len(cycles) > 0 {
+		markdown += "\n### Dependency cycle groups\n"
+		markdown += "*Remove dependencies of a group until none of its issues depends on itself through the others.*\n"
+		for _, cycle := range cycles {
+			var links []string
+			for _, l := range g.CycleLinks(cycle) {
+				links = append(links, l[0]+" depends on "+l[1])
+			}
+			markdown += "- " + strings.Join(cycle, ", ") + ": " + strings.Join(links, "; ") + "\n"
+		}
+	}
+
+	stages, stuck := g.Stages()
+	markdown += "\n### Can start now\n"
+	switch {
+	case !slices.ContainsFunc(g.Keys, func(key string) bool { return g.Nodes[key].Open() }):
+		markdown += "*Nothing, there are no open issues*\n"
+	case len(stages) == 0:
+		markdown += "*Nothing, every open issue is blocked*\n"
+	default:
+		for _, n := range stages[0] {
+			markdown += "- " + g.label(n, single) + "\n"
+		}
+	}
+	if len(stages) > 1 {
+		markdown += "\n### Next\n"
+		for i, stage := range stages[1:] {
+			for _, n := range stage {
+				markdown += fmt.Sprintf("%d. %s\n", i+2, g.label(n, single))
+			}
+		}
+	}
+	if len(stuck) > 0 {
+		markdown += "\n### Blocked by a cycle\n"
+		for _, n := range stuck {
+			markdown += "- " + g.label(n, single) + "\n"
+		}
+	}
+	return markdown
+}
@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+func graphIssue(index int64, title string, state forgejo.StateType) *forgejo.Issue {
+	return &forgejo.Issue{Index: index, Title: title, State: state}
+}
+
+func TestDependencyGraph(t *testing.T) {
+	g := NewDependencyGraph()
+	login := g.AddIssue("org/web", graphIssue(12, `Fix "login"`, forgejo.StateOpen))
+	session := g.AddIssue("org/web", graphIssue(10, "Add session store", forgejo.StateOpen))
+	schema := g.AddIssue("org/api", graphIssue(3, "Session schema", forgejo.StateClosed))
+	docs := g.AddIssue("org/web", graphIssue(14, "Document login", forgejo.StateOpen))
+	g.AddDependency(login.Key, session.Key)
+	g.AddDependency(session.Key, schema.Key)
+	g.AddDependency(docs.Key, login.Key)
+	g.AddDependency(docs.Key, login.Key)
+
+	if g.AddIssue("org/web", graphIssue(12, "again", forgejo.StateOpen)) != login {
+		t.Error("AddIssue did not return the existing node")
+	}
+	if g.Links() != 3 {
+		t.Errorf("Links() = %d, want 3", g.Links())
+	}
+	if cycles := g.Cycles(); len(cycles) != 0 {
+		t.Errorf("Cycles() = %v, want none", cycles)
+	}
+
+	output := g.ToMarkdown()
+	assertContains(t, output, []string{
+		"## Dependency graph: 4 issues, 3 links",
+		"```mermaid\nflowchart TD\n",
+		`n0["org/web#12 Fix #quot;login#quot;"]`,
+		`n2["org/api#3 Session schema"]`,
+		"n1 --> n0",
+		"n2 --> n1",
+		"n0 --> n3",
+		"class n2 closed",
+		"### Can start now\n- org/web#10 Add session store\n",
+		"### Next\n2. org/web#12 Fix \"login\"\n3. org/web#14 Document login\n",
+	})
+	if strings.Contains(output, "### Dependency cycle groups") {
+		t.Errorf("acyclic graph reported cycles:\n%s", output)
+	}
+}
+
+func TestDependencyGraph_Cycles(t *testing.T) {
+	g := NewDependencyGraph()
+	a := g.AddIssue("org/web", graphIssue(1, "A", forgejo.StateOpen))
+	b := g.AddIssue("org/web", graphIssue(2, "B", forgejo.StateOpen))
+	c := g.AddIssue("org/web", graphIssue(3, "C", forgejo.StateOpen))
+	d := g.AddIssue("org/web", graphIssue(4, "D", forgejo.StateOpen))
+	g.AddDependency(a.Key, b.Key)
+	g.AddDependency(b.Key, a.Key)
+	g.AddDependency(c.Key, a.Key)
+
+	cycles := g.Cycles()
+	if len(cycles) != 1 || strings.Join(cycles[0], ",") != "org/web#1,org/web#2" {
+		t.Fatalf("Cycles() = %v", cycles)
+	}
+
+	stages, stuck := g.Stages()
+	if len(stages) != 1 || len(stages[0]) != 1 || stages[0][0] != d {
+		t.Errorf("stages = %v, want only #4", stages)
+	}
+	if len(stuck) != 3 {
+		t.Errorf("stuck = %d issues, want 3", len(stuck))
+	}
+
+	assertContains(t, g.ToMarkdown(), []string{
+		"### Dependency cycle groups\n",
+		"- org/web#1, org/web#2: org/web#1 depends on org/web#2; org/web#2 depends on org/web#1\n",
+		"### Can start now\n- #4 D\n",
+		"### Blocked by a cycle\n- #1 A\n- #2 B\n- #3 C\n",
+	})
+}
+
+func TestDependencyGraph_CycleGroupOutOfOrder(t *testing.T) {
+	g := NewDependencyGraph()
+	a := g.AddIssue("org/web", graphIssue(1, "A", forgejo.StateOpen))
+	b := g.AddIssue("org/web", graphIssue(2, "B", forgejo.StateOpen))
+	c := g.AddIssue("org/web", graphIssue(3, "C", forgejo.StateOpen))
+	// the cycle runs #1 → #3 → #2 → #1, against the insertion order
+	g.AddDependency(a.Key, c.Key)
+	g.AddDependency(c.Key, b.Key)
+	g.AddDependency(b.Key, a.Key)
+
+	cycles := g.Cycles()
+	if len(cycles) != 1 || len(cycles[0]) != 3 {
+		t.Fatalf("Cycles() = %v", cycles)
+	}
+	links := g.CycleLinks(cycles[0])
+	want := [][2]string{{a.Key, c.Key}, {b.Key, a.Key}, {c.Key, b.Key}}
+	if len(links) != len(want) {
+		t.Fatalf("CycleLinks() = %v, want %v", links, want)
+	}
+	for i := range want {
+		if links[i] != want[i] {
+			t.Errorf("CycleLinks()[%d] = %v, want %v", i, links[i], want[i])
+		}
+	}
+
+	output := g.ToMarkdown()
+	assertContains(t, output, []string{
+		"- org/web#1, org/web#2, org/web#3: org/web#1 depends on org/web#3; org/web#2 depends on org/web#1; org/web#3 depends on org/web#2\n",
+	})
+	if strings.Contains(output, "org/web#1 depends on org/web#2") || strings.Contains(output, "→") {
+		t.Errorf("cycle group reported a dependency that does not exist:\n%s", output)
+	}
+}
+
+func TestDependencyGraph_AllClosed(t *testing.T) {
+	g := NewDependencyGraph()
+	a := g.AddIssue("org/web", graphIssue(1, "A", forgejo.StateClosed))
+	b := g.AddIssue("org/web", graphIssue(2, "B", forgejo.StateClosed))
+	g.AddDependency(a.Key, b.Key)
+
+	output := g.ToMarkdown()
+	assertContains(t, output, []string{"### Can start now\n*Nothing, there are no open issues*\n"})
+	if strings.Contains(output, "blocked") {
+		t.Errorf("closed graph reported as blocked:\n%s", output)
+	}
+}
+
+func TestDependencyGraph_Empty(t *testing.T) {
+	assertContains(t, NewDependencyGraph().ToMarkdown(), []string{"*No issues found*"})
+}
@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+// MovedIssueBody returns the body of the copy of a moved issue, crediting
+// the original author. source is the owner/repo of the original issue.
+// Example:
+// *Moved from org/project#12, originally opened by @alice on 2024-01-15 14:30.*
+//
+// Login fails with 500...
+func MovedIssueBody(issue *forgejo.Issue, source string) string {
+	author := "someone"
+	if issue.Poster != nil {
+		author = "@" + issue.Poster.UserName
+	}
+	body := fmt.Sprintf("*Moved from %s#%d, originally opened by %s on %s.*",
+		source, issue.Index, author, issue.Created.Format("2006-01-02 15:04"))
+	if strings.TrimSpace(issue.Body) != "" {
+		body += "\n\n" + issue.Body
+	}
+	return body
+}
+
+// MovedCommentBody quotes a comment of a moved issue with its original
+// author and time.
+// Example:
+// *@bob commented on 2024-01-15 15:00:*
+//
+// > Same here on Firefox.
+func MovedCommentBody(c *forgejo.Comment) string {
+	author := "someone"
+	switch {
+	case c.Poster != nil:
+		author = "@" + c.Poster.UserName
+	case c.OriginalAuthor != "":
+		author = c.OriginalAuthor
+	}
+	body := fmt.Sprintf("*%s commented on %s:*\n\n", author, c.Created.Format("2006-01-02 15:04"))
+	return body + "> " + strings.ReplaceAll(strings.TrimSpace(c.Body), "\n", "\n> ")
+}
+
+// IssueMove describes the outcome of moving an issue to another repository.
+// Fields other than Source and Target list what could not be carried over.
+type IssueMove struct {
+	Source            string
+	Target            string
+	TargetURL         string
+	Comments          int
+	UnmappedLabels    []string
+	UnmappedMilestone string
+	DroppedAssignees  []string
+	Closed            bool
+	Problems          []string
+}
+
+// ToMarkdown renders the moved issue and everything that needs attention
+// Example:
+// Moved org/project#12 to org/backend#3
+// https://forgejo.example.com/org/backend/issues/3
+//
+// - Comments copied: 4
+// - Labels missing in target: needs-triage
+// - Original issue closed
+func (m *IssueMove) ToMarkdown() string {
+	markdown := fmt.Sprintf("Moved %s to %s\n", m.Source, m.Target)
+	if m.TargetURL != "" {
+		markdown += m.TargetURL + "\n"
+	}
+	markdown += fmt.Sprintf("\n- Comments copied: %d\n", m.Comments)
+	if len(m.UnmappedLabels) > 0 {
+		markdown += "- Labels missing in target: " + strings.Join(m.UnmappedLabels, ", ") + "\n"
+	}
+	if m.UnmappedMilestone != "" {
+		markdown += "- Milestone missing in target: " + m.UnmappedMilestone + "\n"
+	}
+	if len(m.DroppedAssignees) > 0 {
+		markdown += "- Assignees not assignable in target: @" + strings.Join(m.DroppedAssignees, ", @") + "\n"
+	}
+	if m.Closed {
+		markdown += "- Original issue closed\n"
+	} else {
+		markdown += "- Original issue left open\n"
+	}
+	for _, p := range m.Problems {
+		markdown += "- **Problem:** " + p + "\n"
+	}
+	return markdown
+}
@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"testing"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+func TestMovedIssueBody(t *testing.T) {
+	issue := &forgejo.Issue{Index: 12, Body: "Login fails with 500", Poster: testUser(), Created: testTime()}
+	got := MovedIssueBody(issue, "org/project")
+	want := "*Moved from org/project#12, originally opened by @testuser on 2024-01-15 14:30.*\n\nLogin fails with 500"
+	if got != want {
+		t.Errorf("MovedIssueBody() = %q, want %q", got, want)
+	}
+}
+
+func TestMovedCommentBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment *forgejo.Comment
+		want    string
+	}{
+		{
+			name:    "multi-line body is quoted",
+			comment: &forgejo.Comment{Poster: testUser(), Body: "Same here.\nOn Firefox too.\n", Created: testTime()},
+			want:    "*@testuser commented on 2024-01-15 14:30:*\n\n> Same here.\n> On Firefox too.",
+		},
+		{
+			name:    "migrated comment keeps original author",
+			comment: &forgejo.Comment{OriginalAuthor: "gh-user", Body: "Imported", Created: testTime()},
+			want:    "*gh-user commented on 2024-01-15 14:30:*\n\n> Imported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MovedCommentBody(tt.comment); got != tt.want {
+				t.Errorf("MovedCommentBody() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueMove_ToMarkdown(t *testing.T) {
+	move := &IssueMove{
+		Source:            "org/project#12",
+		Target:            "org/backend#3",
+		TargetURL:         "https://example.com/org/backend/issues/3",
+		Comments:          4,
+		UnmappedLabels:    []string{"needs-triage", "ui"},
+		UnmappedMilestone: "v1.0",
+		DroppedAssignees:  []string{"carol"},
+		Problems:          []string{"failed to close original issue: 403 Forbidden"},
+	}
+	assertContains(t, move.ToMarkdown(), []string{
+		"Moved org/project#12 to org/backend#3",
+		"https://example.com/org/backend/issues/3",
+		"- Comments copied: 4",
+		"- Labels missing in target: needs-triage, ui",
+		"- Milestone missing in target: v1.0",
+		"- Assignees not assignable in target: @carol",
+		"- Original issue left open",
+		"- **Problem:** failed to close original issue: 403 Forbidden",
+	})
+}
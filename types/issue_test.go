@@ -39,6 +39,19 @@ func TestIssue_ToMarkdown(t *testing.T) {
 			},
 			required: []string{"#123", "Fix login bug", "open", "testuser", "[testuser]", "[bug priority-high]", "v1.0.0", "2024-01-15", "The login page crashes"},
 		},
+		{
+			name: "pinned and locked issue",
+			issue: &Issue{
+				Issue: &forgejo.Issue{
+					Index:    7,
+					Title:    "Roadmap discussion",
+					State:    "open",
+					IsLocked: true,
+				},
+				PinOrder: 1,
+			},
+			required: []string{"**#7 Roadmap discussion** (open, pinned, locked)"},
+		},
 		{
 			name:     "nil issue",
 			issue:    &Issue{Issue: nil},
@@ -104,6 +117,20 @@ func TestIssueList_ToMarkdown(t *testing.T) {
 	}
 }
 
+func TestPinnedIssueList_ToMarkdown(t *testing.T) {
+	list := PinnedIssueList{
+		Issues: IssueList{
+			&forgejo.Issue{Index: 7, Title: "Roadmap discussion", State: "open", IsLocked: true},
+			&forgejo.Issue{Index: 8, Title: "Typo", State: "open"},
+		},
+		Pinned: map[int64]bool{7: true},
+	}
+	assertContains(t, list.ToMarkdown(), []string{
+		"#7 Roadmap discussion (open, pinned, locked)",
+		"#8 Typo (open)",
+	})
+}
+
 func TestComment_ToMarkdown(t *testing.T) {
 	created := testTime()
 	tests := []struct {
@@ -136,3 +163,42 @@ func TestComment_ToMarkdown(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueSearchList_ToMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     IssueSearchList
+		required []string
+	}{
+		{
+			name: "issues and pull requests from several repositories",
+			list: IssueSearchList{
+				{
+					Index: 123, Title: "Fix login bug", State: "open", Comments: 5,
+					Assignees:  []*forgejo.User{testUser()},
+					Repository: &forgejo.RepositoryMeta{FullName: "org/project"},
+				},
+				{
+					Index: 7, Title: "Add dark mode", State: "open",
+					Repository:  &forgejo.RepositoryMeta{FullName: "org/web"},
+					PullRequest: &forgejo.PullRequestMeta{},
+				},
+			},
+			required: []string{
+				"org/project#123 Fix login bug (open) | [testuser] | 5",
+				"org/web#7 [PR] Add dark mode (open) | 0",
+			},
+		},
+		{
+			name:     "empty list",
+			list:     IssueSearchList{},
+			required: []string{"No issues found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertContains(t, tt.list.ToMarkdown(), tt.required)
+		})
+	}
+}
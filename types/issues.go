@@ -9,20 +9,31 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
 )
 
+// MyIssue extends the SDK issue with fields it does not decode.
+type MyIssue struct {
+	forgejo.Issue
+	// PinOrder is the position among pinned issues, 0 if not pinned.
+	PinOrder int `json:"pin_order"`
+}
+
 // Issue represents an issue response with embedded SDK issue
 // Used by endpoints:
+// - GET /repos/{owner}/{repo}/issues/{index} (get, with PinOrder)
 // - POST /repos/{owner}/{repo}/issues (create)
 // - PATCH /repos/{owner}/{repo}/issues/{index} (edit)
 type Issue struct {
 	*forgejo.Issue
+	// PinOrder is only known when the issue was loaded as MyIssue.
+	PinOrder int
 }
 
 // ToMarkdown renders issue with title, state, assignees, labels and basic info
-// Example: **#123 Fix login bug** (open)
+// Example: **#123 Fix login bug** (open, pinned, locked)
 // Author: johndoe
 // Assignees: [alice bob]
 // Labels: [bug priority-high]
@@ -34,7 +45,14 @@ func (i *Issue) ToMarkdown() string {
 	if i.Issue == nil {
 		return "*Invalid issue*"
 	}
-	markdown := fmt.Sprintf("**#%d %s** (%s)\n", i.Index, i.Title, i.State)
+	state := string(i.State)
+	if i.PinOrder > 0 {
+		state += ", pinned"
+	}
+	if i.IsLocked {
+		state += ", locked"
+	}
+	markdown := fmt.Sprintf("**#%d %s** (%s)\n", i.Index, i.Title, state)
 	if i.Poster != nil {
 		markdown += "Author: " + i.Poster.UserName + "\n"
 	}
@@ -95,52 +113,133 @@ func (c *Comment) ToMarkdown() string {
 // Used by list_repo_issues endpoint to show essential information only
 type IssueList []*forgejo.Issue
 
+// PinnedIssueList is an issue list of one repository which also marks the
+// pinned issues, a state the issues of a list do not carry in the SDK
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/issues (list)
+// - GET /repos/{owner}/{repo}/issues/pinned (pinned)
+type PinnedIssueList struct {
+	Issues IssueList
+	// Pinned holds the indexes of the pinned issues.
+	Pinned map[int64]bool
+}
+
 // ToMarkdown renders issues with essential information for quick scanning
 // Shows: Index, Title, State, Assignees, Labels, Updated time, Comments count
 // Example per issue:
 // #123 Fix login bug (open) | [testuser] | [bug priority-high] | 2024-01-15 | 5 comments
 func (il IssueList) ToMarkdown() string {
-	if len(il) == 0 {
+	return PinnedIssueList{Issues: il}.ToMarkdown()
+}
+
+// ToMarkdown renders the issues like IssueList, adding pinned to the state
+// Example per issue:
+// #7 Roadmap discussion (open, pinned, locked) | 2024-01-15 | 12 comments
+func (l PinnedIssueList) ToMarkdown() string {
+	if len(l.Issues) == 0 {
 		return "*No issues found*"
 	}
 
 	markdown := ""
-	for _, issue := range il {
+	for _, issue := range l.Issues {
 		if issue == nil {
 			continue
 		}
+		markdown += fmt.Sprintf("#%d %s\n", issue.Index, issueSummary(issue, l.Pinned[issue.Index]))
+	}
 
-		// Index, Title, and State
-		line := fmt.Sprintf("#%d %s (%s)", issue.Index, issue.Title, issue.State)
+	return markdown
+}
 
-		// Assignees
-		if len(issue.Assignees) > 0 {
-			assigneeNames := make([]string, len(issue.Assignees))
-			for i, assignee := range issue.Assignees {
-				assigneeNames[i] = assignee.UserName
-			}
-			line += " | [" + strings.Join(assigneeNames, " ") + "]"
-		}
+// issueSummary renders title, state, assignees, labels, updated time and
+// comments count of an issue on one line.
+func issueSummary(issue *forgejo.Issue, pinned bool) string {
+	// Title and State
+	state := string(issue.State)
+	if pinned {
+		state += ", pinned"
+	}
+	if issue.IsLocked {
+		state += ", locked"
+	}
+	line := fmt.Sprintf("%s (%s)", issue.Title, state)
 
-		// Labels
-		if len(issue.Labels) > 0 {
-			labelNames := make([]string, len(issue.Labels))
-			for i, label := range issue.Labels {
-				labelNames[i] = label.Name
-			}
-			line += " | [" + strings.Join(labelNames, " ") + "]"
+	// Assignees
+	if len(issue.Assignees) > 0 {
+		assigneeNames := make([]string, len(issue.Assignees))
+		for i, assignee := range issue.Assignees {
+			assigneeNames[i] = assignee.UserName
 		}
+		line += " | [" + strings.Join(assigneeNames, " ") + "]"
+	}
 
-		// Updated time
-		if !issue.Updated.IsZero() {
-			line += " | " + issue.Updated.Format("2006-01-02")
+	// Labels
+	if len(issue.Labels) > 0 {
+		labelNames := make([]string, len(issue.Labels))
+		for i, label := range issue.Labels {
+			labelNames[i] = label.Name
 		}
+		line += " | [" + strings.Join(labelNames, " ") + "]"
+	}
 
-		// Comments count
-		line += fmt.Sprintf(" | %d", issue.Comments)
+	// Updated time
+	if !issue.Updated.IsZero() {
+		line += " | " + issue.Updated.Format("2006-01-02")
+	}
+
+	// Comments count
+	line += fmt.Sprintf(" | %d", issue.Comments)
+
+	return line
+}
+
+// MyIssueSearchOption holds the filters of the cross-repository issue search.
+// The SDK's ListIssueOption uses parameter names this endpoint ignores.
+type MyIssueSearchOption struct {
+	Type            string // "issues" or "pulls", empty for both
+	State           string
+	Labels          []string
+	Milestones      []string
+	Query           string
+	Assigned        bool // assigned to the authenticated user
+	Created         bool // created by the authenticated user
+	Mentioned       bool // mentioning the authenticated user
+	ReviewRequested bool // review requested from the authenticated user
+	Owner           string
+	Team            string
+	Since           time.Time // updated since
+	Page            int
+	Limit           int
+}
+
+// IssueSearchList represents issues found across repositories
+// Used by endpoints:
+// - GET /repos/issues/search
+type IssueSearchList []*forgejo.Issue
 
-		markdown += line + "\n"
+// ToMarkdown renders issues with repository-qualified references, marking
+// pull requests
+// Example per issue:
+// org/project#123 Fix login bug (open) | [testuser] | [bug] | 2024-01-15 | 5
+// org/web#7 [PR] Add dark mode (open) | 2024-01-14 | 0
+func (il IssueSearchList) ToMarkdown() string {
+	if len(il) == 0 {
+		return "*No issues found*"
 	}
 
+	markdown := ""
+	for _, issue := range il {
+		if issue == nil {
+			continue
+		}
+		ref := fmt.Sprintf("#%d", issue.Index)
+		if issue.Repository != nil {
+			ref = issue.Repository.FullName + ref
+		}
+		if issue.PullRequest != nil {
+			ref += " [PR]"
+		}
+		markdown += ref + " " + issueSummary(issue, false) + "\n"
+	}
 	return markdown
 }
@@ -0,0 +1,239 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var labelColorPattern = regexp.MustCompile(`^[0-9a-f]{6}$`)
+
+// LabelSpec is the wanted state of a label, matched by name. A label named
+// after one of the aliases is renamed, keeping it on its issues.
+type LabelSpec struct {
+	Name        string
+	Color       string
+	Description string
+	Exclusive   bool
+	Aliases     []string
+}
+
+// LabelSpecOf takes the wanted state from an existing label.
+func LabelSpecOf(l *MyLabel) LabelSpec {
+	return LabelSpec{Name: l.Name, Color: normalizeLabelColor(l.Color), Description: l.Description, Exclusive: l.Exclusive}
+}
+
+func normalizeLabelColor(color string) string {
+	return strings.ToLower(strings.TrimPrefix(color, "#"))
+}
+
+// ParseLabelSpecs reads a YAML list of labels with name, color (hex, with or
+// without #), description, exclusive and aliases.
+// Example: [{name: priority/high, color: ee0701, exclusive: true, aliases: [urgent]}]
+func ParseLabelSpecs(data []byte) ([]LabelSpec, error) {
+	var raw []struct {
+		Name        string   `yaml:"name"`
+		Color       string   `yaml:"color"`
+		Description string   `yaml:"description"`
+		Exclusive   bool     `yaml:"exclusive"`
+		Aliases     []string `yaml:"aliases"`
+	}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid label spec: %w", err)
+	}
+
+	specs := make([]LabelSpec, 0, len(raw))
+	seen := map[string]bool{}
+	for i, r := range raw {
+		spec := LabelSpec{
+			Name:        strings.TrimSpace(r.Name),
+			Color:       normalizeLabelColor(r.Color),
+			Description: r.Description,
+			Exclusive:   r.Exclusive,
+			Aliases:     r.Aliases,
+		}
+		if spec.Name == "" {
+			return nil, fmt.Errorf("label %d has no name", i+1)
+		}
+		if !labelColorPattern.MatchString(spec.Color) {
+			return nil, fmt.Errorf("label '%s' has invalid color '%s', expected 6 hex digits", spec.Name, r.Color)
+		}
+		for _, name := range append([]string{spec.Name}, spec.Aliases...) {
+			if seen[name] {
+				return nil, fmt.Errorf("label name '%s' is used twice", name)
+			}
+			seen[name] = true
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// LabelChange is what a sync does to one label of one repository. Want is
+// nil when the label is deleted, Existing is nil when it is created.
+type LabelChange struct {
+	Repo     string
+	Want     *LabelSpec
+	Existing *MyLabel
+	Changes  []string
+	Err      error
+}
+
+// Kind names the change: create, rename, update, delete or unchanged.
+func (c *LabelChange) Kind() string {
+	switch {
+	case c.Want == nil:
+		return "delete"
+	case c.Existing == nil:
+		return "create"
+	case c.Existing.Name != c.Want.Name:
+		return "rename"
+	case len(c.Changes) > 0:
+		return "update"
+	}
+	return "unchanged"
+}
+
+// DiffLabels compares the labels of repo with the wanted ones. Labels that
+// are not wanted are deleted only with prune.
+func DiffLabels(repo string, existing []*MyLabel, want []LabelSpec, prune bool) []*LabelChange {
+	byName := map[string]*MyLabel{}
+	for _, l := range existing {
+		byName[l.Name] = l
+	}
+
+	var changes []*LabelChange
+	matched := map[int64]bool{}
+	for i := range want {
+		spec := &want[i]
+		c := &LabelChange{Repo: repo, Want: spec, Existing: byName[spec.Name]}
+		for _, alias := range spec.Aliases {
+			if c.Existing != nil {
+				break
+			}
+			c.Existing = byName[alias]
+		}
+		changes = append(changes, c)
+		if c.Existing == nil {
+			continue
+		}
+		matched[c.Existing.ID] = true
+
+		if c.Existing.Name != spec.Name {
+			c.Changes = append(c.Changes, fmt.Sprintf("rename %s → %s", c.Existing.Name, spec.Name))
+		}
+		if have := normalizeLabelColor(c.Existing.Color); have != spec.Color {
+			c.Changes = append(c.Changes, fmt.Sprintf("color #%s → #%s", have, spec.Color))
+		}
+		if c.Existing.Description != spec.Description {
+			c.Changes = append(c.Changes, "description")
+		}
+		if c.Existing.Exclusive != spec.Exclusive {
+			c.Changes = append(c.Changes, fmt.Sprintf("exclusive → %t", spec.Exclusive))
+		}
+	}
+
+	if prune {
+		for _, l := range existing {
+			if !matched[l.ID] {
+				changes = append(changes, &LabelChange{Repo: repo, Existing: l})
+			}
+		}
+	}
+	return changes
+}
+
+// LabelSync is the plan or the outcome of synchronising labels across
+// repositories.
+type LabelSync struct {
+	Changes []*LabelChange
+	// Applied is false for a dry run.
+	Applied bool
+	// Skipped lists repositories left out, with the reason.
+	Skipped []string
+}
+
+// ToMarkdown renders the counts and, per repository, every label created,
+// renamed, updated or deleted; unchanged labels are only counted
+// Example:
+// ## Label sync (dry run): 1 create, 1 rename, 0 update, 0 delete, 5 unchanged
+//
+// ### org/web
+// - **create** priority/high `#ee0701` (exclusive)
+// - **rename** urgent: rename urgent → priority/high, exclusive → true
+func (s *LabelSync) ToMarkdown() string {
+	kinds := []string{"create", "rename", "update", "delete", "unchanged"}
+	counts := map[string]int{}
+	failed := 0
+	for _, c := range s.Changes {
+		if c.Err != nil {
+			failed++
+			continue
+		}
+		counts[c.Kind()]++
+	}
+
+	markdown := "## Label sync"
+	if !s.Applied {
+		markdown += " (dry run)"
+	}
+	for i, kind := range kinds {
+		sep := ", "
+		if i == 0 {
+			sep = ": "
+		}
+		markdown += fmt.Sprintf("%s%d %s", sep, counts[kind], kind)
+	}
+	if failed > 0 {
+		markdown += fmt.Sprintf(", **%d failed**", failed)
+	}
+	markdown += "\n"
+
+	repo := ""
+	for _, c := range s.Changes {
+		kind := c.Kind()
+		if kind == "unchanged" && c.Err == nil {
+			continue
+		}
+		if c.Repo != repo {
+			repo = c.Repo
+			markdown += "\n### " + repo + "\n"
+		}
+		var line string
+		switch kind {
+		case "create":
+			line = fmt.Sprintf("- **create** %s `#%s`", c.Want.Name, c.Want.Color)
+			if c.Want.Exclusive {
+				line += " (exclusive)"
+			}
+		case "delete":
+			line = fmt.Sprintf("- **delete** %s", c.Existing.Name)
+		default:
+			line = fmt.Sprintf("- **%s** %s: %s", kind, c.Existing.Name, strings.Join(c.Changes, ", "))
+		}
+		if c.Err != nil {
+			line += fmt.Sprintf(" — **failed**: %v", c.Err)
+		}
+		markdown += line + "\n"
+	}
+
+	if len(s.Skipped) > 0 {
+		markdown += "\n### Skipped\n"
+		for _, reason := range s.Skipped {
+			markdown += "- " + reason + "\n"
+		}
+	}
+	if !s.Applied && slices.ContainsFunc(s.Changes, func(c *LabelChange) bool { return c.Kind() != "unchanged" }) {
+		markdown += "\n*Dry run: nothing was changed. Call again with confirm=true to apply.*\n"
+	}
+	return markdown
+}
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseLabelSpecs(t *testing.T) {
+	specs, err := ParseLabelSpecs([]byte(`
+- name: priority/high
+  color: "#EE0701"
+  exclusive: true
+  aliases: [urgent]
+- name: bug
+  color: d73a4a
+  description: Something isn't working
+`))
+	if err != nil {
+		t.Fatalf("ParseLabelSpecs() error = %v", err)
+	}
+	if len(specs) != 2 || specs[0].Color != "ee0701" || !specs[0].Exclusive || specs[0].Aliases[0] != "urgent" {
+		t.Errorf("specs = %+v", specs)
+	}
+
+	for _, bad := range []string{"- color: ffffff", "- name: a\n  color: red", "- name: a\n  color: ffffff\n- name: b\n  color: ffffff\n  aliases: [a]"} {
+		if _, err := ParseLabelSpecs([]byte(bad)); err == nil {
+			t.Errorf("ParseLabelSpecs(%q) succeeded, want error", bad)
+		}
+	}
+}
+
+func TestLabelSync_ToMarkdown(t *testing.T) {
+	want := []LabelSpec{
+		{Name: "priority/high", Color: "ee0701", Exclusive: true, Aliases: []string{"urgent"}},
+		{Name: "bug", Color: "d73a4a", Description: "Something isn't working"},
+		{Name: "docs", Color: "0075ca"},
+		{Name: "feature", Color: "a2eeef"},
+	}
+	existing := []*MyLabel{
+		{ID: 1, Name: "urgent", Color: "ff0000"},
+		{ID: 2, Name: "bug", Color: "D73A4A", Description: "Something isn't working"},
+		{ID: 3, Name: "docs", Color: "0075ca", Description: "Old"},
+		{ID: 4, Name: "wontfix", Color: "ffffff"},
+	}
+
+	changes := DiffLabels("org/web", existing, want, true)
+	var kinds []string
+	for _, c := range changes {
+		kinds = append(kinds, c.Kind())
+	}
+	if got := strings.Join(kinds, ","); got != "rename,unchanged,update,create,delete" {
+		t.Errorf("kinds = %s", got)
+	}
+	if len(DiffLabels("org/web", existing, want, false)) != 4 {
+		t.Error("DiffLabels without prune deleted labels")
+	}
+
+	output := (&LabelSync{Changes: changes}).ToMarkdown()
+	assertContains(t, output, []string{
+		"## Label sync (dry run): 1 create, 1 rename, 1 update, 1 delete, 1 unchanged",
+		"- **rename** urgent: rename urgent → priority/high, color #ff0000 → #ee0701, exclusive → true",
+		"- **update** docs: description",
+		"- **create** feature `#a2eeef`",
+		"- **delete** wontfix",
+		"Call again with confirm=true",
+	})
+	if strings.Contains(output, "- **unchanged**") {
+		t.Errorf("output lists unchanged labels:\n%s", output)
+	}
+
+	changes[4].Err = errors.New("forbidden")
+	assertContains(t, (&LabelSync{Changes: changes, Applied: true}).ToMarkdown(), []string{
+		"## Label sync: 1 create, 1 rename, 1 update, 0 delete, 1 unchanged, **1 failed**",
+		"- **delete** wontfix — **failed**: forbidden",
+	})
+}
+
+func TestMyLabelList_ToMarkdown(t *testing.T) {
+	output := MyLabelList{
+		{ID: 7, Name: "priority/high", Color: "ee0701", Exclusive: true, Description: "Fix first"},
+		{ID: 8, Name: "old", Color: "#cccccc", IsArchived: true},
+	}.ToMarkdown()
+	assertContains(t, output, []string{
+		"- **priority/high** `#ee0701` (exclusive) - Fix first (ID: 7)",
+		"- **old** `#cccccc` (archived) (ID: 8)",
+	})
+	assertContains(t, MyLabelList{}.ToMarkdown(), []string{"*No labels found*"})
+}
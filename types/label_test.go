@@ -60,6 +60,15 @@ func TestLabelList_ToMarkdown(t *testing.T) {
 			},
 			required: []string{"bug", "ff0000", "Something isn't working", "enhancement", "a2eeef", "New feature or request"},
 		},
+		{
+			name: "scoped labels nested under their scope",
+			labels: LabelList{
+				&Label{Label: &forgejo.Label{Name: "priority/high", Color: "ee0701"}},
+				&Label{Label: testLabel()},
+				&Label{Label: &forgejo.Label{Name: "priority/low", Color: "c2e0c6"}},
+			},
+			required: []string{"- **bug** `#ff0000` - Something isn't working\n- priority/\n  - **priority/high** `#ee0701`\n  - **priority/low** `#c2e0c6`\n"},
+		},
 		{
 			name:     "empty label list",
 			labels:   LabelList{},
@@ -74,3 +83,23 @@ func TestLabelList_ToMarkdown(t *testing.T) {
 		})
 	}
 }
+
+func TestScopedLabelConflicts(t *testing.T) {
+	if ScopeOfLabel("area/ui/forms") != "area/ui" || ScopeOfLabel("bug") != "" || ScopeOfLabel("/odd") != "" || ScopeOfLabel("priority/") != "" {
+		t.Error("ScopeOfLabel() returned wrong scopes")
+	}
+
+	labels := []*forgejo.Label{
+		{ID: 1, Name: "priority/low"},
+		{ID: 2, Name: "priority/high"},
+		{ID: 3, Name: "bug"},
+		{ID: 4, Name: "status/wip"},
+	}
+	conflicts := ScopedLabelConflicts(labels, []int64{2, 3})
+	if len(conflicts) != 1 || conflicts[0].ID != 1 {
+		t.Errorf("ScopedLabelConflicts() = %v, want only priority/low", conflicts)
+	}
+	if len(ScopedLabelConflicts(labels, []int64{3})) != 0 {
+		t.Error("an unscoped label conflicts with other labels")
+	}
+}
@@ -7,6 +7,10 @@
 package types
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
 )
 
@@ -45,17 +49,158 @@ func (l *Label) ToMarkdown() string {
 // - PUT /repos/{owner}/{repo}/issues/{index}/labels
 type LabelList []*Label
 
-// ToMarkdown renders labels as a bullet list of colored badges
+// ToMarkdown renders labels as a bullet list of colored badges, with
+// scoped labels nested under their scope
 // Example:
 // - **bug** `#ff0000` - Something isn't working
-// - **enhancement** `#a2eeef` - New feature or request
+// - priority/
+//   - **priority/high** `#ee0701`
 func (ll LabelList) ToMarkdown() string {
 	if len(ll) == 0 {
 		return "*No labels found*"
 	}
-	markdown := ""
-	for _, label := range ll {
-		markdown += "- " + label.ToMarkdown() + "\n"
+	return scopedLabelList(len(ll), func(i int) (string, string) {
+		return ll[i].Name, ll[i].ToMarkdown()
+	})
+}
+
+// ScopeOfLabel returns the scope of a label name, the part before the last
+// '/', or "" for an unscoped label. As in Forgejo, a name starting or ending
+// with that '/' has no scope. Exclusive labels of the same scope replace each
+// other on an issue.
+func ScopeOfLabel(name string) string {
+	i := strings.LastIndex(name, "/")
+	if i <= 0 || i == len(name)-1 {
+		return ""
+	}
+	return name[:i]
+}
+
+// ScopedLabelConflicts returns the labels, other than the added ones, that
+// share a scope with an added label.
+func ScopedLabelConflicts(labels []*forgejo.Label, added []int64) []*forgejo.Label {
+	scopes := map[string]bool{}
+	for _, l := range labels {
+		if slices.Contains(added, l.ID) && ScopeOfLabel(l.Name) != "" {
+			scopes[ScopeOfLabel(l.Name)] = true
+		}
+	}
+	var conflicts []*forgejo.Label
+	for _, l := range labels {
+		if !slices.Contains(added, l.ID) && scopes[ScopeOfLabel(l.Name)] {
+			conflicts = append(conflicts, l)
+		}
+	}
+	return conflicts
+}
+
+// scopedLabelList renders n labels as a bullet list: unscoped labels first,
+// then each scope in order of appearance with its labels nested. item
+// returns the name and the rendering of the i-th label.
+func scopedLabelList(n int, item func(i int) (name, markdown string)) string {
+	var (
+		markdown string
+		scopes   []string
+		nested   = map[string]string{}
+	)
+	for i := range n {
+		name, line := item(i)
+		scope := ScopeOfLabel(name)
+		if scope == "" {
+			markdown += "- " + line + "\n"
+			continue
+		}
+		if _, ok := nested[scope]; !ok {
+			scopes = append(scopes, scope)
+		}
+		nested[scope] += "  - " + line + "\n"
+	}
+	for _, scope := range scopes {
+		markdown += "- " + scope + "/\n" + nested[scope]
 	}
 	return markdown
 }
+
+// MyLabel is a label including the fields the SDK leaves out. An exclusive
+// label is scoped by the part of its name before the last '/': an issue can
+// carry only one exclusive label of each scope.
+// This type is not available in the Forgejo SDK.
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/labels
+// - GET /orgs/{org}/labels
+// - POST /repos/{owner}/{repo}/labels, POST /orgs/{org}/labels
+// - PATCH /repos/{owner}/{repo}/labels/{id}, PATCH /orgs/{org}/labels/{id}
+type MyLabel struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Exclusive   bool   `json:"exclusive"`
+	IsArchived  bool   `json:"is_archived"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+}
+
+// ToMarkdown renders a label like Label.ToMarkdown, marking exclusive and
+// archived labels
+// Example: **priority/high** `#ff0000` (exclusive) - Fix first
+func (l *MyLabel) ToMarkdown() string {
+	markdown := "**" + l.Name + "**"
+	if l.Color != "" {
+		markdown += " `#" + strings.TrimPrefix(l.Color, "#") + "`"
+	}
+	if l.Exclusive {
+		markdown += " (exclusive)"
+	}
+	if l.IsArchived {
+		markdown += " (archived)"
+	}
+	if l.Description != "" {
+		markdown += " - " + l.Description
+	}
+	return markdown
+}
+
+// MyLabelList represents a list of labels with their exclusive flag
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/labels
+// - GET /orgs/{org}/labels
+type MyLabelList []*MyLabel
+
+// ToMarkdown renders labels as a bullet list with ID, with scoped labels
+// nested under their scope
+// Example:
+// - **bug** `#ff0000` - Something isn't working (ID: 1)
+// - priority/
+//   - **priority/high** `#ee0701` (exclusive) (ID: 7)
+func (ll MyLabelList) ToMarkdown() string {
+	if len(ll) == 0 {
+		return "*No labels found*"
+	}
+	return scopedLabelList(len(ll), func(i int) (string, string) {
+		return ll[i].Name, fmt.Sprintf("%s (ID: %d)", ll[i].ToMarkdown(), ll[i].ID)
+	})
+}
+
+// MyCreateLabelOption creates a label with the exclusive flag.
+// This type is not available in the Forgejo SDK.
+// Used by endpoints:
+// - POST /repos/{owner}/{repo}/labels
+// - POST /orgs/{org}/labels
+type MyCreateLabelOption struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+	Exclusive   bool   `json:"exclusive"`
+}
+
+// MyEditLabelOption edits a label; nil fields are left unchanged.
+// This type is not available in the Forgejo SDK.
+// Used by endpoints:
+// - PATCH /repos/{owner}/{repo}/labels/{id}
+// - PATCH /orgs/{org}/labels/{id}
+type MyEditLabelOption struct {
+	Name        *string `json:"name,omitempty"`
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Exclusive   *bool   `json:"exclusive,omitempty"`
+}
@@ -0,0 +1,277 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+const (
+	// VelocityDays is the window used to project when a milestone will be
+	// done.
+	VelocityDays = 14
+	// maxBurndownPoints bounds the points of the burndown chart; longer
+	// milestones are sampled.
+	maxBurndownPoints = 30
+)
+
+// BurndownPoint is the number of open issues at the end of a day.
+type BurndownPoint struct {
+	Date time.Time
+	Open int
+}
+
+// LabelCount is the number of remaining issues carrying a label.
+type LabelCount struct {
+	Label string
+	Open  int
+}
+
+// MilestoneReport describes the progress of a milestone from the close
+// dates of its issues.
+type MilestoneReport struct {
+	Milestone *forgejo.Milestone
+	Now       time.Time
+	Total     int
+	Closed    int
+	// ClosedRecently counts the issues closed within VelocityDays.
+	ClosedRecently   int
+	RemainingByLabel []LabelCount
+	Overdue          []*forgejo.Issue
+	Burndown         []BurndownPoint
+}
+
+// BuildMilestoneReport computes the report of a milestone from all its
+// issues, open and closed. The burndown runs daily from the creation of the
+// milestone to now, or to its close date. Issues count from their creation,
+// as the API does not tell when they were added to the milestone.
+func BuildMilestoneReport(m *forgejo.Milestone, issues []*forgejo.Issue, now time.Time) *MilestoneReport {
+	r := &MilestoneReport{Milestone: m, Now: now, Total: len(issues)}
+	recent := now.AddDate(0, 0, -VelocityDays)
+	labels := map[string]int{}
+	for _, issue := range issues {
+		if issue.State == forgejo.StateClosed {
+			r.Closed++
+			if issue.Closed != nil && issue.Closed.After(recent) {
+				r.ClosedRecently++
+			}
+			continue
+		}
+		if issue.Deadline != nil && !issue.Deadline.IsZero() && issue.Deadline.Before(now) {
+			r.Overdue = append(r.Overdue, issue)
+		}
+		if len(issue.Labels) == 0 {
+			labels["(no label)"]++
+		}
+		for _, l := range issue.Labels {
+			labels[l.Name]++
+		}
+	}
+	for label, open := range labels {
+		r.RemainingByLabel = append(r.RemainingByLabel, LabelCount{Label: label, Open: open})
+	}
+	slices.SortFunc(r.RemainingByLabel, func(a, b LabelCount) int {
+		return cmp.Or(cmp.Compare(b.Open, a.Open), cmp.Compare(a.Label, b.Label))
+	})
+	slices.SortFunc(r.Overdue, func(a, b *forgejo.Issue) int {
+		return a.Deadline.Compare(*b.Deadline)
+	})
+
+	start := m.Created
+	if start.IsZero() {
+		for _, issue := range issues {
+			if start.IsZero() || issue.Created.Before(start) {
+				start = issue.Created
+			}
+		}
+	}
+	end := now
+	if m.Closed != nil && m.Closed.Before(end) {
+		end = *m.Closed
+	}
+	if start.IsZero() || start.After(end) {
+		return r
+	}
+	for day := startOfDay(start); !day.After(end); day = day.AddDate(0, 0, 1) {
+		eod := day.AddDate(0, 0, 1)
+		point := BurndownPoint{Date: day}
+		for _, issue := range issues {
+			if issue.Created.Before(eod) && (issue.Closed == nil || !issue.Closed.Before(eod)) {
+				point.Open++
+			}
+		}
+		r.Burndown = append(r.Burndown, point)
+	}
+	return r
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// Percent returns the share of closed issues.
+func (r *MilestoneReport) Percent() int {
+	if r.Total == 0 {
+		return 0
+	}
+	return r.Closed * 100 / r.Total
+}
+
+// Projection estimates when the remaining issues will be closed at the pace
+// of the last VelocityDays. ok is false when nothing was closed recently.
+func (r *MilestoneReport) Projection() (done time.Time, ok bool) {
+	open := r.Total - r.Closed
+	if open == 0 {
+		return r.Now, true
+	}
+	if r.ClosedRecently == 0 {
+		return time.Time{}, false
+	}
+	days := (open*VelocityDays + r.ClosedRecently - 1) / r.ClosedRecently
+	return r.Now.AddDate(0, 0, days), true
+}
+
+// Status answers whether the milestone is on track for its due date.
+func (r *MilestoneReport) Status() string {
+	m := r.Milestone
+	open := r.Total - r.Closed
+	switch {
+	case r.Total == 0:
+		return "No issues in this milestone"
+	case m.State == forgejo.StateClosed:
+		return fmt.Sprintf("Closed with %d/%d issues done", r.Closed, r.Total)
+	case open == 0:
+		return "Done: every issue is closed"
+	}
+
+	done, ok := r.Projection()
+	velocity := fmt.Sprintf("%d closed in the last %d days", r.ClosedRecently, VelocityDays)
+	switch {
+	case m.Deadline == nil || m.Deadline.IsZero():
+		if !ok {
+			return "No due date; no progress recently (" + velocity + ")"
+		}
+		return fmt.Sprintf("No due date; projected done by %s (%s)", done.Format("2006-01-02"), velocity)
+	case m.Deadline.Before(r.Now):
+		return fmt.Sprintf("**Late**: was due %s, %d issues still open", m.Deadline.Format("2006-01-02"), open)
+	case !ok:
+		return fmt.Sprintf("**At risk**: due %s, %d issues open and %s", m.Deadline.Format("2006-01-02"), open, velocity)
+	case done.After(*m.Deadline):
+		return fmt.Sprintf("**At risk**: due %s but projected done by %s (%s)", m.Deadline.Format("2006-01-02"), done.Format("2006-01-02"), velocity)
+	default:
+		return fmt.Sprintf("On track: due %s, projected done by %s (%s)", m.Deadline.Format("2006-01-02"), done.Format("2006-01-02"), velocity)
+	}
+}
+
+// Chart renders the burndown as a Mermaid xychart with the ideal line from
+// the first day to the due date. Long milestones are sampled down to
+// maxBurndownPoints, always keeping the last day.
+func (r *MilestoneReport) Chart() string {
+	if len(r.Burndown) == 0 {
+		return ""
+	}
+	step := (len(r.Burndown) + maxBurndownPoints - 1) / maxBurndownPoints
+	var points []BurndownPoint
+	for i := 0; i < len(r.Burndown); i += step {
+		points = append(points, r.Burndown[i])
+	}
+	if last := r.Burndown[len(r.Burndown)-1]; points[len(points)-1] != last {
+		points = append(points, last)
+	}
+
+	var (
+		dates, actual, ideal []string
+		top                  int
+	)
+	first := points[0]
+	for _, p := range points {
+		dates = append(dates, `"`+p.Date.Format("01-02")+`"`)
+		actual = append(actual, fmt.Sprint(p.Open))
+		top = max(top, p.Open)
+	}
+	deadline := r.Milestone.Deadline
+	if deadline != nil && !deadline.IsZero() {
+		span := deadline.Sub(first.Date).Hours()
+		for _, p := range points {
+			left := float64(first.Open)
+			if span > 0 {
+				left -= float64(first.Open) * p.Date.Sub(first.Date).Hours() / span
+			}
+			ideal = append(ideal, fmt.Sprintf("%.1f", max(left, 0)))
+		}
+	}
+
+	chart := "```mermaid\nxychart-beta\n"
+	chart += fmt.Sprintf("    title \"Burndown of %s\"\n", strings.ReplaceAll(r.Milestone.Title, `"`, "'"))
+	chart += "    x-axis [" + strings.Join(dates, ", ") + "]\n"
+	chart += fmt.Sprintf("    y-axis \"Open issues\" 0 --> %d\n", max(top, 1))
+	chart += "    line [" + strings.Join(actual, ", ") + "]\n"
+	if len(ideal) > 0 {
+		chart += "    line [" + strings.Join(ideal, ", ") + "]\n"
+	}
+	return chart + "```\n"
+}
+
+// ToMarkdown renders the status, progress, remaining issues per label,
+// overdue issues and the burndown chart
+// Example:
+// ## Milestone v2.3
+//
+// On track: due 2024-02-01, projected done by 2024-01-25 (6 closed in the last 14 days)
+//
+// Progress: 12/16 issues closed (75%)
+//
+// ### Remaining by label
+// | Label | Open |
+// |-------|------|
+// | bug | 3 |
+//
+// ### Overdue
+// - #42 Fix login (due 2024-01-10)
+//
+// ### Burndown
+// ```mermaid
+// xychart-beta
+// ...
+// ```
+func (r *MilestoneReport) ToMarkdown() string {
+	markdown := fmt.Sprintf("## Milestone %s\n\n", r.Milestone.Title)
+	markdown += r.Status() + "\n"
+	if r.Total == 0 {
+		return markdown
+	}
+	markdown += fmt.Sprintf("\nProgress: %d/%d issues closed (%d%%)\n", r.Closed, r.Total, r.Percent())
+
+	if len(r.RemainingByLabel) > 0 {
+		markdown += "\n### Remaining by label\n"
+		markdown += "| Label | Open |\n"
+		markdown += "|-------|------|\n"
+		for _, l := range r.RemainingByLabel {
+			markdown += fmt.Sprintf("| %s | %d |\n", l.Label, l.Open)
+		}
+	}
+	if len(r.Overdue) > 0 {
+		markdown += "\n### Overdue\n"
+		for _, issue := range r.Overdue {
+			markdown += fmt.Sprintf("- #%d %s (due %s)\n", issue.Index, issue.Title, issue.Deadline.Format("2006-01-02"))
+		}
+	}
+	if chart := r.Chart(); chart != "" {
+		markdown += "\n### Burndown\n" + chart
+		if r.Milestone.Deadline != nil && !r.Milestone.Deadline.IsZero() {
+			markdown += "\nThe first line is the open issue count, the second the ideal pace to the due date.\n"
+		}
+	}
+	return markdown
+}
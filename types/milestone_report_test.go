@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+func TestBuildMilestoneReport(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, 1, d, 9, 0, 0, 0, time.UTC)
+	}
+	ptr := func(t time.Time) *time.Time { return &t }
+	bug := []*forgejo.Label{{Name: "bug"}}
+
+	deadline := time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+	m := &forgejo.Milestone{Title: "v2.3", State: forgejo.StateOpen, Created: day(10), Deadline: &deadline}
+	report := BuildMilestoneReport(m, []*forgejo.Issue{
+		{Index: 1, Title: "Crash", State: forgejo.StateClosed, Created: day(10), Closed: ptr(day(12)), Labels: bug},
+		{Index: 2, Title: "Fix login", State: forgejo.StateOpen, Created: day(10), Deadline: ptr(day(13)), Labels: bug},
+		{Index: 3, Title: "Docs", State: forgejo.StateOpen, Created: day(11)},
+		{Index: 4, Title: "Cleanup", State: forgejo.StateClosed, Created: day(10), Closed: ptr(day(14))},
+	}, testTime())
+
+	var series []string
+	for _, p := range report.Burndown {
+		series = append(series, fmt.Sprintf("%s=%d", p.Date.Format("01-02"), p.Open))
+	}
+	if got := strings.Join(series, " "); got != "01-10=3 01-11=4 01-12=3 01-13=3 01-14=2 01-15=2" {
+		t.Errorf("burndown = %s", got)
+	}
+	if report.Percent() != 50 || report.ClosedRecently != 2 {
+		t.Errorf("Percent()=%d ClosedRecently=%d, want 50 and 2", report.Percent(), report.ClosedRecently)
+	}
+
+	assertContains(t, report.ToMarkdown(), []string{
+		"## Milestone v2.3",
+		"**At risk**: due 2024-01-20 but projected done by 2024-01-29 (2 closed in the last 14 days)",
+		"Progress: 2/4 issues closed (50%)",
+		"| bug | 1 |",
+		"| (no label) | 1 |",
+		"### Overdue\n- #2 Fix login (due 2024-01-13)",
+		"```mermaid\nxychart-beta\n",
+		`x-axis ["01-10", "01-11", "01-12", "01-13", "01-14", "01-15"]`,
+		"y-axis \"Open issues\" 0 --> 4",
+		"line [3, 4, 3, 3, 2, 2]",
+		"line [3.0, 2.7, 2.4, 2.1, 1.8, 1.5]",
+	})
+}
+
+func TestMilestoneReport_Status(t *testing.T) {
+	now := testTime()
+	due := func(days int) *time.Time {
+		d := now.AddDate(0, 0, days)
+		return &d
+	}
+	tests := []struct {
+		name   string
+		report MilestoneReport
+		want   string
+	}{
+		{"empty", MilestoneReport{Milestone: &forgejo.Milestone{}}, "No issues in this milestone"},
+		{"done", MilestoneReport{Milestone: &forgejo.Milestone{}, Total: 3, Closed: 3}, "Done: every issue is closed"},
+		{"late", MilestoneReport{Milestone: &forgejo.Milestone{Deadline: due(-1)}, Total: 3, Closed: 1}, "**Late**: was due 2024-01-14, 2 issues still open"},
+		{"stalled", MilestoneReport{Milestone: &forgejo.Milestone{Deadline: due(10)}, Total: 3}, "**At risk**: due 2024-01-25, 3 issues open and 0 closed"},
+		{"on track", MilestoneReport{Milestone: &forgejo.Milestone{Deadline: due(30)}, Total: 10, Closed: 7, ClosedRecently: 7}, "On track: due 2024-02-14, projected done by 2024-01-21"},
+		{"no due date", MilestoneReport{Milestone: &forgejo.Milestone{}, Total: 2, Closed: 1, ClosedRecently: 1}, "No due date; projected done by 2024-01-29"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.report.Now = now
+			if got := tt.report.Status(); !strings.Contains(got, tt.want) {
+				t.Errorf("Status() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
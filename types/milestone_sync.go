@@ -0,0 +1,216 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// MilestoneSpec is the wanted state of a milestone, matched by title.
+type MilestoneSpec struct {
+	Title       string
+	Description string
+	Deadline    *time.Time
+	State       forgejo.StateType
+}
+
+// MilestoneSpecOf takes the wanted state from an existing milestone.
+func MilestoneSpecOf(m *forgejo.Milestone) MilestoneSpec {
+	spec := MilestoneSpec{Title: m.Title, Description: m.Description, State: m.State}
+	if m.Deadline != nil && !m.Deadline.IsZero() {
+		spec.Deadline = m.Deadline
+	}
+	return spec
+}
+
+// ParseMilestoneSpecs reads a YAML list of milestones. due_on is a date
+// (YYYY-MM-DD) or RFC3339 time, state defaults to open.
+// Example: [{title: v2.3, description: Spring release, due_on: 2024-03-01}]
+func ParseMilestoneSpecs(data []byte) ([]MilestoneSpec, error) {
+	var raw []struct {
+		Title       string `yaml:"title"`
+		Description string `yaml:"description"`
+		DueOn       string `yaml:"due_on"`
+		State       string `yaml:"state"`
+	}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid milestone spec: %w", err)
+	}
+
+	specs := make([]MilestoneSpec, 0, len(raw))
+	seen := map[string]bool{}
+	for i, r := range raw {
+		spec := MilestoneSpec{Title: strings.TrimSpace(r.Title), Description: r.Description, State: forgejo.StateOpen}
+		if spec.Title == "" {
+			return nil, fmt.Errorf("milestone %d has no title", i+1)
+		}
+		if seen[spec.Title] {
+			return nil, fmt.Errorf("milestone '%s' is listed twice", spec.Title)
+		}
+		seen[spec.Title] = true
+
+		switch r.State {
+		case "", "open":
+		case "closed":
+			spec.State = forgejo.StateClosed
+		default:
+			return nil, fmt.Errorf("milestone '%s' has invalid state '%s', expected open or closed", spec.Title, r.State)
+		}
+		if r.DueOn != "" {
+			due, err := time.Parse(time.DateOnly, r.DueOn)
+			if err != nil {
+				if due, err = time.Parse(time.RFC3339, r.DueOn); err != nil {
+					return nil, fmt.Errorf("milestone '%s' has invalid due_on '%s', expected YYYY-MM-DD or RFC3339", spec.Title, r.DueOn)
+				}
+			}
+			spec.Deadline = &due
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// MilestoneChange is what a sync does to one milestone of one repository.
+// Existing is nil when the milestone is created; Changes is empty when it
+// is already up to date. Err is set when applying the change failed.
+type MilestoneChange struct {
+	Repo     string
+	Want     MilestoneSpec
+	Existing *forgejo.Milestone
+	Changes  []string
+	Err      error
+}
+
+// Create reports whether the milestone is missing from the repository.
+func (c *MilestoneChange) Create() bool {
+	return c.Existing == nil
+}
+
+// DiffMilestones compares the milestones of repo with the wanted ones.
+// Milestones of the repository which are not wanted are left alone, and so
+// are due dates and descriptions the spec leaves empty. Due dates are
+// compared by day.
+func DiffMilestones(repo string, existing []*forgejo.Milestone, want []MilestoneSpec) []*MilestoneChange {
+	byTitle := map[string]*forgejo.Milestone{}
+	for _, m := range existing {
+		byTitle[m.Title] = m
+	}
+
+	changes := make([]*MilestoneChange, 0, len(want))
+	for _, spec := range want {
+		c := &MilestoneChange{Repo: repo, Want: spec, Existing: byTitle[spec.Title]}
+		changes = append(changes, c)
+		if c.Create() {
+			continue
+		}
+
+		if have, want := dueDate(c.Existing.Deadline), dueDate(spec.Deadline); spec.Deadline != nil && have != want {
+			c.Changes = append(c.Changes, fmt.Sprintf("due date %s → %s", have, want))
+		}
+		if spec.Description != "" && c.Existing.Description != spec.Description {
+			c.Changes = append(c.Changes, "description")
+		}
+		if c.Existing.State != spec.State {
+			c.Changes = append(c.Changes, fmt.Sprintf("state %s → %s", c.Existing.State, spec.State))
+		}
+	}
+	return changes
+}
+
+func dueDate(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "none"
+	}
+	return t.Format(time.DateOnly)
+}
+
+// MilestoneSync is the plan or the outcome of synchronising milestones
+// across repositories.
+type MilestoneSync struct {
+	Changes []*MilestoneChange
+	// Applied is false for a dry run.
+	Applied bool
+	// Skipped lists repositories left out, with the reason.
+	Skipped []string
+}
+
+// ToMarkdown renders the counts and, per repository, the milestones created
+// or updated; up-to-date milestones are only counted
+// Example:
+// ## Milestone sync (dry run): 1 to create, 1 to update, 4 up to date
+//
+// ### org/web
+// - **create** v2.3 (due 2024-03-01)
+// - **update** v2.2: due date 2024-02-01 → 2024-02-15, description
+func (s *MilestoneSync) ToMarkdown() string {
+	var create, update, same, failed int
+	for _, c := range s.Changes {
+		switch {
+		case c.Err != nil:
+			failed++
+		case c.Create():
+			create++
+		case len(c.Changes) > 0:
+			update++
+		default:
+			same++
+		}
+	}
+
+	verbs := [2]string{"to create", "to update"}
+	title := "## Milestone sync (dry run)"
+	if s.Applied {
+		verbs = [2]string{"created", "updated"}
+		title = "## Milestone sync"
+	}
+	markdown := fmt.Sprintf("%s: %d %s, %d %s, %d up to date", title, create, verbs[0], update, verbs[1], same)
+	if failed > 0 {
+		markdown += fmt.Sprintf(", **%d failed**", failed)
+	}
+	markdown += "\n"
+
+	repo := ""
+	for _, c := range s.Changes {
+		if c.Err == nil && !c.Create() && len(c.Changes) == 0 {
+			continue
+		}
+		if c.Repo != repo {
+			repo = c.Repo
+			markdown += "\n### " + repo + "\n"
+		}
+		var line string
+		if c.Create() {
+			line = fmt.Sprintf("- **create** %s (due %s", c.Want.Title, dueDate(c.Want.Deadline))
+			if c.Want.State == forgejo.StateClosed {
+				line += ", closed"
+			}
+			line += ")"
+		} else {
+			line = fmt.Sprintf("- **update** %s: %s", c.Want.Title, strings.Join(c.Changes, ", "))
+		}
+		if c.Err != nil {
+			line += fmt.Sprintf(" — **failed**: %v", c.Err)
+		}
+		markdown += line + "\n"
+	}
+
+	if len(s.Skipped) > 0 {
+		markdown += "\n### Skipped\n"
+		for _, reason := range s.Skipped {
+			markdown += "- " + reason + "\n"
+		}
+	}
+	if !s.Applied && create+update > 0 {
+		markdown += "\n*Dry run: nothing was changed. Call again with confirm=true to apply.*\n"
+	}
+	return markdown
+}
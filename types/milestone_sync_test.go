@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+func TestParseMilestoneSpecs(t *testing.T) {
+	specs, err := ParseMilestoneSpecs([]byte(`
+- title: v2.3
+  description: Spring release
+  due_on: 2024-03-01
+- title: v2.2
+  state: closed
+`))
+	if err != nil {
+		t.Fatalf("ParseMilestoneSpecs() error = %v", err)
+	}
+	if len(specs) != 2 || specs[0].Title != "v2.3" || specs[0].Deadline.Format(time.DateOnly) != "2024-03-01" || specs[0].State != forgejo.StateOpen {
+		t.Errorf("specs[0] = %+v", specs[0])
+	}
+	if specs[1].State != forgejo.StateClosed || specs[1].Deadline != nil {
+		t.Errorf("specs[1] = %+v", specs[1])
+	}
+
+	for _, bad := range []string{"- description: no title", "- title: a\n- title: a", "- title: a\n  state: done", "- title: a\n  due_on: March", "title: a"} {
+		if _, err := ParseMilestoneSpecs([]byte(bad)); err == nil {
+			t.Errorf("ParseMilestoneSpecs(%q) succeeded, want error", bad)
+		}
+	}
+}
+
+func TestMilestoneSync_ToMarkdown(t *testing.T) {
+	due := func(s string) *time.Time {
+		d, _ := time.Parse(time.DateOnly, s)
+		return &d
+	}
+	want := []MilestoneSpec{
+		{Title: "v2.3", Description: "Spring", Deadline: due("2024-03-01"), State: forgejo.StateOpen},
+		{Title: "v2.2", Description: "Winter", Deadline: due("2024-02-15"), State: forgejo.StateOpen},
+		{Title: "v2.1", State: forgejo.StateClosed},
+	}
+	existing := []*forgejo.Milestone{
+		{Title: "v2.2", Description: "Old", Deadline: due("2024-02-01"), State: forgejo.StateOpen},
+		{Title: "v2.1", Description: "Kept", Deadline: due("2024-01-01"), State: forgejo.StateClosed},
+		{Title: "v1.0", State: forgejo.StateClosed},
+	}
+
+	changes := DiffMilestones("org/web", existing, want)
+	if len(changes) != 3 || !changes[0].Create() || len(changes[2].Changes) != 0 {
+		t.Fatalf("DiffMilestones() = %+v", changes)
+	}
+	if got := strings.Join(changes[1].Changes, ", "); got != "due date 2024-02-01 → 2024-02-15, description" {
+		t.Errorf("v2.2 changes = %s", got)
+	}
+
+	plan := &MilestoneSync{Changes: changes, Skipped: []string{"org/old: archived"}}
+	output := plan.ToMarkdown()
+	assertContains(t, output, []string{
+		"## Milestone sync (dry run): 1 to create, 1 to update, 1 up to date",
+		"### org/web\n- **create** v2.3 (due 2024-03-01)\n- **update** v2.2: due date 2024-02-01 → 2024-02-15, description\n",
+		"### Skipped\n- org/old: archived",
+		"Call again with confirm=true",
+	})
+	if strings.Contains(output, "v2.1") || strings.Contains(output, "v1.0") {
+		t.Errorf("output lists untouched milestones:\n%s", output)
+	}
+
+	changes[0].Err = errors.New("permission denied")
+	applied := &MilestoneSync{Changes: changes, Applied: true}
+	assertContains(t, applied.ToMarkdown(), []string{
+		"## Milestone sync: 0 created, 1 updated, 1 up to date, **1 failed**",
+		"- **create** v2.3 (due 2024-03-01) — **failed**: permission denied",
+	})
+}
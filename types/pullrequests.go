@@ -68,3 +68,14 @@ func (prl PullRequestList) ToMarkdown() string {
 	}
 	return markdown
 }
+
+// MyPullRequestListOption holds the filters of listing the pull requests of
+// a repository. The SDK's ListPullRequestsOptions has no label filter.
+type MyPullRequestListOption struct {
+	State     string
+	Sort      string
+	Milestone int64
+	Labels    []int64
+	Page      int
+	Limit     int
+}
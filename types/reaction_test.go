@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"testing"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+func TestNormalizeReaction(t *testing.T) {
+	tests := map[string]string{
+		"+1":       "+1",
+		":+1:":     "+1",
+		"👍":        "+1",
+		"thumbsup": "+1",
+		":tada:":   "hooray",
+		"❤️":       "heart",
+		"custom":   "custom",
+	}
+	for in, want := range tests {
+		if got := NormalizeReaction(in); got != want {
+			t.Errorf("NormalizeReaction(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestReactionList_ToMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     ReactionList
+		required []string
+	}{
+		{
+			name: "grouped by reaction, most frequent first",
+			list: ReactionList{
+				{User: &forgejo.User{UserName: "dave"}, Reaction: "heart"},
+				{User: &forgejo.User{UserName: "alice"}, Reaction: "+1"},
+				{User: &forgejo.User{UserName: "bob"}, Reaction: "+1"},
+			},
+			required: []string{"- :+1: × 2 (@alice, @bob)\n- :heart: × 1 (@dave)"},
+		},
+		{
+			name:     "empty list",
+			list:     ReactionList{},
+			required: []string{"No reactions"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertContains(t, tt.list.ToMarkdown(), tt.required)
+		})
+	}
+}
+
+func TestReaction_ToMarkdown(t *testing.T) {
+	r := &Reaction{Reaction: &forgejo.Reaction{User: &forgejo.User{UserName: "alice"}, Reaction: "rocket"}}
+	assertContains(t, r.ToMarkdown(), []string{":rocket: by @alice"})
+}
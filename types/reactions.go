@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+// DefaultReactions is the reaction set Forgejo enables unless the instance
+// configures its own (ui.REACTIONS).
+var DefaultReactions = []string{"+1", "-1", "laugh", "hooray", "confused", "heart", "rocket", "eyes"}
+
+var reactionAliases = map[string]string{
+	"👍":          "+1",
+	"thumbsup":   "+1",
+	"👎":          "-1",
+	"thumbsdown": "-1",
+	"😄":          "laugh",
+	"smile":      "laugh",
+	"🎉":          "hooray",
+	"tada":       "hooray",
+	"😕":          "confused",
+	"❤️":         "heart",
+	"❤":          "heart",
+	"🚀":          "rocket",
+	"👀":          "eyes",
+}
+
+// NormalizeReaction converts ':+1:', '👍' or 'thumbsup' to the name Forgejo
+// expects ('+1'). Unknown names are returned as is, since instances may
+// enable additional reactions.
+func NormalizeReaction(reaction string) string {
+	reaction = strings.Trim(strings.TrimSpace(reaction), ":")
+	if name, ok := reactionAliases[reaction]; ok {
+		return name
+	}
+	return reaction
+}
+
+// Reaction represents a reaction response with embedded SDK reaction
+// Used by endpoints:
+// - POST /repos/{owner}/{repo}/issues/{index}/reactions
+// - POST /repos/{owner}/{repo}/issues/comments/{id}/reactions
+type Reaction struct {
+	*forgejo.Reaction
+}
+
+// ToMarkdown renders a reaction with its author
+// Example: :+1: by @alice
+func (r *Reaction) ToMarkdown() string {
+	if r.Reaction == nil {
+		return "*Invalid reaction*"
+	}
+	markdown := ":" + r.Reaction.Reaction + ":"
+	if r.User != nil {
+		markdown += " by @" + r.User.UserName
+	}
+	return markdown
+}
+
+// ReactionList represents a list of reactions response
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/issues/{index}/reactions
+// - GET /repos/{owner}/{repo}/issues/comments/{id}/reactions
+type ReactionList []*forgejo.Reaction
+
+// ToMarkdown renders reactions grouped by type, most frequent first
+// Example:
+// - :+1: × 3 (@alice, @bob, @carol)
+// - :heart: × 1 (@dave)
+func (rl ReactionList) ToMarkdown() string {
+	if len(rl) == 0 {
+		return "*No reactions*"
+	}
+
+	users := map[string][]string{}
+	var order []string
+	for _, r := range rl {
+		if _, ok := users[r.Reaction]; !ok {
+			order = append(order, r.Reaction)
+		}
+		name := "unknown"
+		if r.User != nil {
+			name = r.User.UserName
+		}
+		users[r.Reaction] = append(users[r.Reaction], "@"+name)
+	}
+	slices.SortStableFunc(order, func(a, b string) int {
+		return cmp.Compare(len(users[b]), len(users[a]))
+	})
+
+	markdown := ""
+	for _, reaction := range order {
+		markdown += fmt.Sprintf("- :%s: × %d (%s)\n", reaction, len(users[reaction]), strings.Join(users[reaction], ", "))
+	}
+	return markdown
+}
@@ -0,0 +1,281 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+// ReleaseNotesGroups are the ways release notes can be grouped: by
+// conventional-commit type or by label.
+var ReleaseNotesGroups = []string{"type", "label"}
+
+var conventionalPattern = regexp.MustCompile(`^(\w+)(?:\(([^)]*)\))?(!)?: *(.+)$`)
+
+// ConventionalCommit is a commit message or pull request title following
+// the conventional commits format, such as "feat(api)!: drop v1 routes".
+type ConventionalCommit struct {
+	Type        string
+	Scope       string
+	Description string
+	Breaking    bool
+}
+
+// ParseConventionalCommit parses the subject line of a message; the body is
+// only checked for a BREAKING CHANGE footer. ok is false when the subject
+// does not follow the format.
+func ParseConventionalCommit(message string) (c ConventionalCommit, ok bool) {
+	subject, body, _ := strings.Cut(message, "\n")
+	m := conventionalPattern.FindStringSubmatch(strings.TrimSpace(subject))
+	if m == nil {
+		return ConventionalCommit{}, false
+	}
+	return ConventionalCommit{
+		Type:        strings.ToLower(m[1]),
+		Scope:       m[2],
+		Description: m[4],
+		Breaking:    m[3] == "!" || strings.Contains(body, "BREAKING CHANGE") || strings.Contains(body, "BREAKING-CHANGE"),
+	}, true
+}
+
+// releaseNotesTypes maps conventional-commit types to the sections of the
+// notes, in the order they are rendered.
+var releaseNotesTypes = []struct {
+	title string
+	types []string
+}{
+	{"Features", []string{"feat", "feature"}},
+	{"Bug fixes", []string{"fix", "bugfix"}},
+	{"Performance", []string{"perf"}},
+	{"Refactoring", []string{"refactor"}},
+	{"Documentation", []string{"docs", "doc"}},
+	{"Tests", []string{"test", "tests"}},
+	{"Build and CI", []string{"build", "ci"}},
+}
+
+const (
+	breakingSection = "Breaking changes"
+	otherSection    = "Other changes"
+	commitsSection  = "Other commits"
+)
+
+// ReleaseNotesSection is a heading of the notes with its entries.
+type ReleaseNotesSection struct {
+	Title   string
+	Entries []string
+}
+
+// ReleaseNotes collects the merged pull requests and the commits of a
+// release, either between two refs or of a milestone.
+type ReleaseNotes struct {
+	From, To  string
+	Milestone string
+	// GroupBy is one of ReleaseNotesGroups, type when empty.
+	GroupBy string
+	Pulls   []*forgejo.PullRequest
+	// Commits are the commits not merged through one of the pulls.
+	Commits []*forgejo.Commit
+	// Truncated is set when not every merged pull request was loaded; the
+	// commits of the missing ones are then among Commits.
+	Truncated bool
+}
+
+// commitSubject returns the first line of a commit message.
+func commitSubject(c *forgejo.Commit) string {
+	if c.RepoCommit == nil {
+		return ""
+	}
+	subject, _, _ := strings.Cut(c.RepoCommit.Message, "\n")
+	return strings.TrimSpace(subject)
+}
+
+func commitRef(c *forgejo.Commit) string {
+	if c.CommitMeta == nil {
+		return ""
+	}
+	return shortSHA(c.SHA)
+}
+
+// CommitAuthor returns the login of the author of a commit, or the name in
+// the commit when it belongs to no account.
+func CommitAuthor(c *forgejo.Commit) string {
+	if c.Author != nil && c.Author.UserName != "" {
+		return "@" + c.Author.UserName
+	}
+	if c.RepoCommit != nil && c.RepoCommit.Author != nil {
+		return c.RepoCommit.Author.Name
+	}
+	return ""
+}
+
+func pullAuthor(pr *forgejo.PullRequest) string {
+	if pr.Poster == nil {
+		return ""
+	}
+	return "@" + pr.Poster.UserName
+}
+
+// entry renders one change, stripping the conventional prefix when the
+// change is grouped by it.
+func (n *ReleaseNotes) entry(text, ref, author string, cc ConventionalCommit, conventional bool) string {
+	switch {
+	case conventional && n.GroupBy != "label":
+		text = cc.Description
+		if cc.Scope != "" {
+			text = "**" + cc.Scope + "**: " + text
+		}
+	case conventional && cc.Breaking:
+		text = "**Breaking**: " + text
+	}
+	line := fmt.Sprintf("- %s (%s)", text, ref)
+	if author != "" {
+		line += " by " + author
+	}
+	return line
+}
+
+func (n *ReleaseNotes) typeSection(cc ConventionalCommit, conventional bool) string {
+	if !conventional {
+		return otherSection
+	}
+	if cc.Breaking {
+		return breakingSection
+	}
+	for _, t := range releaseNotesTypes {
+		if slices.Contains(t.types, cc.Type) {
+			return t.title
+		}
+	}
+	return otherSection
+}
+
+// labelSection returns the first label of a pull request by name.
+func labelSection(pr *forgejo.PullRequest) string {
+	var names []string
+	for _, l := range pr.Labels {
+		names = append(names, l.Name)
+	}
+	if len(names) == 0 {
+		return "Unlabelled"
+	}
+	slices.Sort(names)
+	return names[0]
+}
+
+// Sections groups the changes. By type, breaking changes come first and
+// unconventional ones last; by label, a pull request is listed under its
+// first label by name and commits under "Other commits".
+func (n *ReleaseNotes) Sections() []ReleaseNotesSection {
+	entries := map[string][]string{}
+	for _, pr := range n.Pulls {
+		cc, ok := ParseConventionalCommit(pr.Title + "\n" + pr.Body)
+		section := n.typeSection(cc, ok)
+		if n.GroupBy == "label" {
+			section = labelSection(pr)
+		}
+		entries[section] = append(entries[section], n.entry(pr.Title, fmt.Sprintf("#%d", pr.Index), pullAuthor(pr), cc, ok))
+	}
+	for _, c := range n.Commits {
+		message := ""
+		if c.RepoCommit != nil {
+			message = c.RepoCommit.Message
+		}
+		cc, ok := ParseConventionalCommit(message)
+		section := n.typeSection(cc, ok)
+		if n.GroupBy == "label" {
+			section = commitsSection
+		}
+		entries[section] = append(entries[section], n.entry(commitSubject(c), commitRef(c), CommitAuthor(c), cc, ok))
+	}
+
+	var order []string
+	if n.GroupBy == "label" {
+		for title := range entries {
+			if title != "Unlabelled" && title != commitsSection {
+				order = append(order, title)
+			}
+		}
+		slices.Sort(order)
+		order = append(order, "Unlabelled", commitsSection)
+	} else {
+		order = append(order, breakingSection)
+		for _, t := range releaseNotesTypes {
+			order = append(order, t.title)
+		}
+		order = append(order, otherSection)
+	}
+
+	var sections []ReleaseNotesSection
+	for _, title := range order {
+		if len(entries[title]) > 0 {
+			sections = append(sections, ReleaseNotesSection{Title: title, Entries: entries[title]})
+		}
+	}
+	return sections
+}
+
+// Contributors lists the authors of the pull requests and commits, sorted.
+func (n *ReleaseNotes) Contributors() []string {
+	var authors []string
+	for _, pr := range n.Pulls {
+		if a := pullAuthor(pr); a != "" {
+			authors = append(authors, a)
+		}
+	}
+	for _, c := range n.Commits {
+		if a := CommitAuthor(c); a != "" {
+			authors = append(authors, a)
+		}
+	}
+	slices.SortFunc(authors, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	return slices.Compact(authors)
+}
+
+// ToMarkdown renders the notes as the body of a release: a section per
+// group, the contributors and the range covered
+// Example:
+// ## Features
+// - **api**: add pagination (#42) by @alice
+//
+// ## Bug fixes
+// - handle empty tokens (`1a2b3c4d`) by @bob
+//
+// ## Contributors
+// @alice, @bob
+//
+// **Full changelog**: v1.2.0...v1.3.0
+func (n *ReleaseNotes) ToMarkdown() string {
+	sections := n.Sections()
+	if len(sections) == 0 {
+		return "*No changes*\n"
+	}
+
+	var parts []string
+	for _, s := range sections {
+		parts = append(parts, "## "+s.Title+"\n"+strings.Join(s.Entries, "\n")+"\n")
+	}
+	if authors := n.Contributors(); len(authors) > 0 {
+		parts = append(parts, "## Contributors\n"+strings.Join(authors, ", ")+"\n")
+	}
+	if n.Truncated {
+		parts = append(parts, "*Not every merged pull request could be loaded: some are missing, and their commits are listed as other commits.*\n")
+	}
+	switch {
+	case n.Milestone != "":
+		parts = append(parts, "**Milestone**: "+n.Milestone+"\n")
+	case n.From != "":
+		parts = append(parts, fmt.Sprintf("**Full changelog**: %s...%s\n", n.From, n.To))
+	}
+	return strings.Join(parts, "\n")
+}
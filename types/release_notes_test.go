@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	tests := []struct {
+		message string
+		want    ConventionalCommit
+		ok      bool
+	}{
+		{"feat: add dark mode", ConventionalCommit{Type: "feat", Description: "add dark mode"}, true},
+		{"fix(api): handle empty tokens\n\nmore text", ConventionalCommit{Type: "fix", Scope: "api", Description: "handle empty tokens"}, true},
+		{"feat(api)!: drop v1 routes", ConventionalCommit{Type: "feat", Scope: "api", Description: "drop v1 routes", Breaking: true}, true},
+		{"refactor: rename config\n\nBREAKING CHANGE: the file moved", ConventionalCommit{Type: "refactor", Description: "rename config", Breaking: true}, true},
+		{"Fix login: handle expired sessions", ConventionalCommit{}, false},
+		{"Update README", ConventionalCommit{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseConventionalCommit(tt.message)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("ParseConventionalCommit(%q) = %+v, %t; want %+v, %t", tt.message, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func testReleaseNotes() *ReleaseNotes {
+	alice := &forgejo.User{UserName: "alice"}
+	commit := func(sha, message string, author *forgejo.User) *forgejo.Commit {
+		return &forgejo.Commit{
+			CommitMeta: &forgejo.CommitMeta{SHA: sha},
+			RepoCommit: &forgejo.RepoCommit{Message: message, Author: &forgejo.CommitUser{Identity: forgejo.Identity{Name: "Bob Builder"}}},
+			Author:     author,
+		}
+	}
+	return &ReleaseNotes{
+		From: "v1.2.0",
+		To:   "v1.3.0",
+		Pulls: []*forgejo.PullRequest{
+			{Index: 42, Title: "feat(api): add pagination", Poster: alice, Labels: []*forgejo.Label{{Name: "kind/feature"}, {Name: "api"}}},
+			{Index: 43, Title: "feat!: drop v1 routes", Poster: testUser()},
+			{Index: 44, Title: "Update screenshots", Poster: alice},
+		},
+		Commits: []*forgejo.Commit{
+			commit("1a2b3c4d5e6f", "fix: handle empty tokens\n\nDetails", nil),
+		},
+	}
+}
+
+func TestReleaseNotes_ToMarkdown(t *testing.T) {
+	output := testReleaseNotes().ToMarkdown()
+	assertContains(t, output, []string{
+		"## Breaking changes\n- drop v1 routes (#43) by @testuser\n",
+		"## Features\n- **api**: add pagination (#42) by @alice\n",
+		"## Bug fixes\n- handle empty tokens (`1a2b3c4d`) by Bob Builder\n",
+		"## Other changes\n- Update screenshots (#44) by @alice\n",
+		"## Contributors\n@alice, @testuser, Bob Builder\n",
+		"**Full changelog**: v1.2.0...v1.3.0",
+	})
+	if strings.Index(output, "## Breaking changes") > strings.Index(output, "## Features") {
+		t.Errorf("breaking changes should come first:\n%s", output)
+	}
+}
+
+func TestReleaseNotes_Truncated(t *testing.T) {
+	notes := testReleaseNotes()
+	if strings.Contains(notes.ToMarkdown(), "Not every merged pull request") {
+		t.Error("complete notes reported as truncated")
+	}
+	notes.Truncated = true
+	assertContains(t, notes.ToMarkdown(), []string{"*Not every merged pull request could be loaded"})
+}
+
+func TestReleaseNotes_GroupByLabel(t *testing.T) {
+	notes := testReleaseNotes()
+	notes.GroupBy = "label"
+
+	var titles []string
+	for _, s := range notes.Sections() {
+		titles = append(titles, s.Title)
+	}
+	if got := strings.Join(titles, ", "); got != "api, Unlabelled, Other commits" {
+		t.Errorf("sections = %s", got)
+	}
+	assertContains(t, notes.ToMarkdown(), []string{
+		"## api\n- feat(api): add pagination (#42) by @alice\n",
+		"- **Breaking**: feat!: drop v1 routes (#43) by @testuser\n",
+		"## Other commits\n- fix: handle empty tokens (`1a2b3c4d`) by Bob Builder\n",
+	})
+}
@@ -164,3 +164,35 @@ func TestAttachmentList_ToMarkdown(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseDetail_ToMarkdown(t *testing.T) {
+	release := &ReleaseDetail{
+		Release: &forgejo.Release{
+			ID:          12,
+			TagName:     "v1.0.0",
+			Title:       "Major Release",
+			Target:      "main",
+			Note:        "New features",
+			HTMLURL:     "https://git.example.com/org/project/releases/tag/v1.0.0",
+			CreatedAt:   testTime(),
+			PublishedAt: testTime(),
+			Publisher:   testUser(),
+			Attachments: []*forgejo.Attachment{
+				{Name: "app-linux.tar.gz", Size: 1048576, DownloadCount: 120, DownloadURL: "https://git.example.com/attachments/1"},
+				{Name: "checksums.txt", Size: 128, DownloadCount: 37},
+			},
+		},
+	}
+	assertContains(t, release.ToMarkdown(), []string{
+		"**v1.0.0** - Major Release (2024-01-15) (ID: 12)\n",
+		"Target: main | Published: 2024-01-15 by testuser\n",
+		"URL: https://git.example.com/org/project/releases/tag/v1.0.0\n",
+		"\nNew features\n",
+		"### Assets (2 files, 157 downloads)",
+		"| [app-linux.tar.gz](https://git.example.com/attachments/1) | 1048576 bytes | 120 |",
+		"| checksums.txt | 128 bytes | 37 |",
+	})
+
+	release.Attachments = nil
+	assertContains(t, release.ToMarkdown(), []string{"*No assets*"})
+}
@@ -8,6 +8,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
 )
@@ -21,8 +22,8 @@ type Release struct {
 	*forgejo.Release
 }
 
-// ToMarkdown renders release with tag, name, draft/prerelease status and description
-// Example: **v1.0.0** - Major Release `PRERELEASE` (2024-01-15)
+// ToMarkdown renders release with tag, name, draft/prerelease status, ID and description
+// Example: **v1.0.0** - Major Release `PRERELEASE` (2024-01-15) (ID: 12)
 // This release includes new authentication system and bug fixes...
 func (r *Release) ToMarkdown() string {
 	if r.Release == nil {
@@ -41,6 +42,9 @@ func (r *Release) ToMarkdown() string {
 	if !r.CreatedAt.IsZero() {
 		markdown += " (" + r.CreatedAt.Format("2006-01-02") + ")"
 	}
+	if r.ID > 0 {
+		markdown += fmt.Sprintf(" (ID: %d)", r.ID)
+	}
 	if r.Note != "" {
 		markdown += "\n" + r.Note
 	}
@@ -68,3 +72,74 @@ func (rl ReleaseList) ToMarkdown() string {
 	}
 	return markdown
 }
+
+// ReleaseDetail represents a single release with its assets
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/releases/{id}
+// - GET /repos/{owner}/{repo}/releases/tags/{tag}
+// - GET /repos/{owner}/{repo}/releases/latest
+type ReleaseDetail struct {
+	*forgejo.Release
+}
+
+// ToMarkdown renders the release with its target, publisher, link, notes
+// and assets with download counts
+// Example:
+// **v1.0.0** - Major Release (2024-01-15) (ID: 12)
+// Target: main | Published: 2024-01-16 by alice
+// URL: https://git.example.com/org/project/releases/tag/v1.0.0
+//
+// This release includes new authentication system...
+//
+// ### Assets (2 files, 157 downloads)
+// | Name | Size | Downloads |
+// |------|------|-----------|
+// | [app-linux.tar.gz](https://git.example.com/attachments/1) | 1048576 bytes | 120 |
+func (r *ReleaseDetail) ToMarkdown() string {
+	if r.Release == nil {
+		return "*Invalid release*"
+	}
+	summary := *r.Release
+	summary.Note = ""
+	markdown := (&Release{Release: &summary}).ToMarkdown() + "\n"
+
+	var meta []string
+	if r.Target != "" {
+		meta = append(meta, "Target: "+r.Target)
+	}
+	if !r.PublishedAt.IsZero() && !r.IsDraft {
+		published := "Published: " + r.PublishedAt.Format("2006-01-02")
+		if r.Publisher != nil {
+			published += " by " + r.Publisher.UserName
+		}
+		meta = append(meta, published)
+	}
+	if len(meta) > 0 {
+		markdown += strings.Join(meta, " | ") + "\n"
+	}
+	if r.HTMLURL != "" {
+		markdown += "URL: " + r.HTMLURL + "\n"
+	}
+	if r.Note != "" {
+		markdown += "\n" + r.Note + "\n"
+	}
+
+	if len(r.Attachments) == 0 {
+		return markdown + "\n*No assets*\n"
+	}
+	var downloads int64
+	for _, a := range r.Attachments {
+		downloads += a.DownloadCount
+	}
+	markdown += fmt.Sprintf("\n### Assets (%d files, %d downloads)\n", len(r.Attachments), downloads)
+	markdown += "| Name | Size | Downloads |\n"
+	markdown += "|------|------|-----------|\n"
+	for _, a := range r.Attachments {
+		name := a.Name
+		if a.DownloadURL != "" {
+			name = "[" + a.Name + "](" + a.DownloadURL + ")"
+		}
+		markdown += fmt.Sprintf("| %s | %d bytes | %d |\n", name, a.Size, a.DownloadCount)
+	}
+	return markdown
+}
@@ -0,0 +1,41 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"testing"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+func TestIssueSubscribers_ToMarkdown(t *testing.T) {
+	tests := []struct {
+		name        string
+		subscribers IssueSubscribers
+		required    []string
+	}{
+		{
+			name: "subscribed with others",
+			subscribers: IssueSubscribers{
+				Users: []*forgejo.User{testUser(), {UserName: "bob"}},
+				Me:    &forgejo.WatchInfo{Subscribed: true},
+			},
+			required: []string{"You are subscribed.", "Subscribers (2): @testuser, @bob"},
+		},
+		{
+			name:        "not subscribed, nobody else",
+			subscribers: IssueSubscribers{Me: &forgejo.WatchInfo{}},
+			required:    []string{"You are not subscribed.", "*No subscribers*"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertContains(t, tt.subscribers.ToMarkdown(), tt.required)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+// IssueSubscribers represents the users subscribed to an issue together
+// with the subscription state of the authenticated user
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/issues/{index}/subscriptions
+// - GET /repos/{owner}/{repo}/issues/{index}/subscriptions/check
+type IssueSubscribers struct {
+	Users []*forgejo.User
+	Me    *forgejo.WatchInfo
+}
+
+// ToMarkdown renders whether you are subscribed and who else is
+// Example:
+// You are subscribed.
+// Subscribers (2): @alice, @bob
+func (s *IssueSubscribers) ToMarkdown() string {
+	markdown := ""
+	switch {
+	case s.Me == nil:
+	case s.Me.Subscribed:
+		markdown += "You are subscribed.\n"
+	case s.Me.Ignored:
+		markdown += "You are ignoring this issue.\n"
+	default:
+		markdown += "You are not subscribed.\n"
+	}
+
+	if len(s.Users) == 0 {
+		return markdown + "*No subscribers*"
+	}
+	names := make([]string, len(s.Users))
+	for i, u := range s.Users {
+		names[i] = "@" + u.UserName
+	}
+	return markdown + fmt.Sprintf("Subscribers (%d): %s", len(names), strings.Join(names, ", "))
+}
@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const bugForm = `name: Bug report
+about: Something does not work
+title: "[Bug]: "
+labels: ["bug", "triage"]
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for reporting!
+  - type: textarea
+    id: what
+    attributes:
+      label: What happened?
+    validations:
+      required: true
+  - type: dropdown
+    id: version
+    attributes:
+      label: Version
+      options: ["1.0", "2.0"]
+  - type: textarea
+    id: logs
+    attributes:
+      label: Logs
+      render: shell
+  - type: checkboxes
+    id: terms
+    attributes:
+      label: Code of conduct
+      options:
+        - label: I agree
+          required: true
+        - label: Subscribe me
+`
+
+func TestTemplateKind(t *testing.T) {
+	tests := []struct {
+		dir, name, want string
+	}{
+		{"ISSUE_TEMPLATE", "bug.yaml", TemplateKindIssue},
+		{"issue_template", "feature.md", TemplateKindIssue},
+		{"ISSUE_TEMPLATE", "config.yml", ""},
+		{"", "PULL_REQUEST_TEMPLATE.md", TemplateKindPullRequest},
+		{"", "issue_template.md", TemplateKindIssue},
+		{"", "README.md", ""},
+		{"workflows", "ci.yml", ""},
+	}
+	for _, tt := range tests {
+		if got := TemplateKind(tt.dir, tt.name); got != tt.want {
+			t.Errorf("TemplateKind(%q, %q) = %q, want %q", tt.dir, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseIssueTemplate_Markdown(t *testing.T) {
+	content := "---\nname: Feature request\nabout: Suggest an idea\ntitle: \"[Feature] \"\nlabels: enhancement, ui\n---\n\n## Problem\n\n## Proposal\n"
+	tmpl := ParseIssueTemplate(TemplateKindIssue, ".forgejo/ISSUE_TEMPLATE/feature.md", []byte(content))
+
+	if tmpl.Name != "Feature request" || tmpl.About != "Suggest an idea" || tmpl.Title != "[Feature] " {
+		t.Errorf("unexpected header: %+v", tmpl)
+	}
+	if !slices.Equal(tmpl.Labels, []string{"enhancement", "ui"}) {
+		t.Errorf("Labels = %v", tmpl.Labels)
+	}
+	if tmpl.Body != "## Problem\n\n## Proposal\n" {
+		t.Errorf("Body = %q", tmpl.Body)
+	}
+	if tmpl.IsForm() || len(tmpl.Errors) > 0 {
+		t.Errorf("expected valid markdown template, got errors %v", tmpl.Errors)
+	}
+
+	body, err := tmpl.RenderBody("", nil)
+	if err != nil || body != tmpl.Body {
+		t.Errorf("RenderBody() = %q, %v", body, err)
+	}
+	if got := tmpl.RenderTitle("Dark mode"); got != "[Feature] Dark mode" {
+		t.Errorf("RenderTitle() = %q", got)
+	}
+	if got := tmpl.RenderTitle("[Feature] Dark mode"); got != "[Feature] Dark mode" {
+		t.Errorf("RenderTitle() with prefix = %q", got)
+	}
+}
+
+func TestParseIssueTemplate_NoFrontMatter(t *testing.T) {
+	tmpl := ParseIssueTemplate(TemplateKindPullRequest, ".github/PULL_REQUEST_TEMPLATE.md", []byte("## Changes\n"))
+	if tmpl.Name != "PULL_REQUEST_TEMPLATE" || tmpl.Body != "## Changes\n" {
+		t.Errorf("unexpected template: %+v", tmpl)
+	}
+}
+
+func TestParseIssueTemplate_Form(t *testing.T) {
+	tmpl := ParseIssueTemplate(TemplateKindIssue, ".forgejo/ISSUE_TEMPLATE/bug.yaml", []byte(bugForm))
+	if len(tmpl.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", tmpl.Errors)
+	}
+	if !tmpl.IsForm() || len(tmpl.Fields) != 5 {
+		t.Fatalf("expected 5 fields, got %d", len(tmpl.Fields))
+	}
+	terms := tmpl.Fields[4]
+	if !slices.Equal(terms.Options, []string{"I agree", "Subscribe me"}) || !slices.Equal(terms.RequiredOptions, []string{"I agree"}) {
+		t.Errorf("checkboxes parsed as %+v", terms)
+	}
+	if !tmpl.Matches("bug") || !tmpl.Matches("bug report") || tmpl.Matches("feature") {
+		t.Error("Matches() does not match by file name and name")
+	}
+
+	assertContains(t, tmpl.ToMarkdown(), []string{
+		"## Bug report (issue template, form)",
+		"File: `.forgejo/ISSUE_TEMPLATE/bug.yaml`",
+		"Labels: bug, triage",
+		"- `what` (textarea, required): What happened?",
+		"- `version` (dropdown): Version - options: 1.0, 2.0",
+		"(must check: I agree)",
+	})
+	assertContains(t, IssueTemplateList{tmpl}.ToMarkdown(), []string{
+		"- **Bug report** (issue, form) `.forgejo/ISSUE_TEMPLATE/bug.yaml` - Something does not work; fields: what*, version, logs, terms*",
+	})
+}
+
+func TestIssueTemplate_RenderBody(t *testing.T) {
+	tmpl := ParseIssueTemplate(TemplateKindIssue, ".forgejo/ISSUE_TEMPLATE/bug.yaml", []byte(bugForm))
+
+	body, err := tmpl.RenderBody("", map[string]any{
+		"what":    "Login fails",
+		"version": "2.0",
+		"logs":    "error 500",
+		"terms":   []any{"I agree"},
+	})
+	if err != nil {
+		t.Fatalf("RenderBody() error = %v", err)
+	}
+	want := "### What happened?\n\nLogin fails\n\n" +
+		"### Version\n\n2.0\n\n" +
+		"### Logs\n\n```shell\nerror 500\n```\n\n" +
+		"### Code of conduct\n\n- [x] I agree\n- [ ] Subscribe me\n"
+	if body != want {
+		t.Errorf("RenderBody() = %q, want %q", body, want)
+	}
+
+	_, err = tmpl.RenderBody("", map[string]any{"version": "3.0", "typo": "x"})
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, problem := range []string{`what: "What happened?" is required`, `version: "3.0" is not one of 1.0, 2.0`, `terms: "I agree" must be checked`, "typo: no such field"} {
+		if !strings.Contains(err.Error(), problem) {
+			t.Errorf("error %q does not mention %q", err, problem)
+		}
+	}
+}
+
+func TestParseIssueTemplate_Invalid(t *testing.T) {
+	content := "name: Broken\nbody:\n  - type: dropdown\n    id: a\n    attributes:\n      label: A\n  - type: slider\n"
+	tmpl := ParseIssueTemplate(TemplateKindIssue, ".gitea/ISSUE_TEMPLATE/broken.yml", []byte(content))
+	assertContains(t, strings.Join(tmpl.Errors, "\n"), []string{
+		`field "a": options are required`,
+		`field 2: unknown type "slider"`,
+	})
+}
@@ -0,0 +1,539 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TemplateDirs are the directories Forgejo looks for issue and pull request
+// templates in, in order of precedence.
+var TemplateDirs = []string{".forgejo", ".gitea", ".github"}
+
+// Kinds of templates.
+const (
+	TemplateKindIssue       = "issue"
+	TemplateKindPullRequest = "pull_request"
+)
+
+// Template field types of YAML issue forms.
+var templateFieldTypes = []string{"markdown", "textarea", "input", "dropdown", "checkboxes"}
+
+// TemplateKind tells whether a file in one of TemplateDirs is a template,
+// returning TemplateKindIssue or TemplateKindPullRequest, or "" if it is not.
+// dir is the path below the template directory, like "ISSUE_TEMPLATE".
+// Names are matched case-insensitively, as Forgejo does.
+func TemplateKind(dir, name string) string {
+	ext := strings.ToLower(path.Ext(name))
+	if ext != ".md" && ext != ".yml" && ext != ".yaml" {
+		return ""
+	}
+	base := strings.ToLower(strings.TrimSuffix(name, path.Ext(name)))
+	switch {
+	case strings.EqualFold(dir, "ISSUE_TEMPLATE"):
+		if base == "config" {
+			return ""
+		}
+		return TemplateKindIssue
+	case dir != "":
+		return ""
+	case base == "issue_template":
+		return TemplateKindIssue
+	case base == "pull_request_template":
+		return TemplateKindPullRequest
+	}
+	return ""
+}
+
+// templateStrings accepts both a YAML list and a comma separated string,
+// as the labels and assignees of templates may be written either way.
+type templateStrings []string
+
+func (s *templateStrings) UnmarshalYAML(n *yaml.Node) error {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		for _, v := range strings.Split(n.Value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				*s = append(*s, v)
+			}
+		}
+		return nil
+	case yaml.SequenceNode:
+		var list []string
+		if err := n.Decode(&list); err != nil {
+			return err
+		}
+		*s = list
+		return nil
+	}
+	return fmt.Errorf("line %d: expected a string or a list", n.Line)
+}
+
+// TemplateField is a field of a YAML issue form.
+type TemplateField struct {
+	Type        string
+	ID          string
+	Label       string
+	Description string
+	Placeholder string
+	// Value is the default value, or the text of a markdown element.
+	Value string
+	// Options of a dropdown, or the boxes of checkboxes.
+	Options []string
+	// RequiredOptions are the checkboxes which must be checked.
+	RequiredOptions []string
+	Multiple        bool
+	Required        bool
+	// Render is the code language a textarea is wrapped in.
+	Render string
+}
+
+// IssueTemplate is a parsed issue or pull request template. Markdown
+// templates carry their content in Body, YAML issue forms in Fields.
+type IssueTemplate struct {
+	Kind      string
+	Path      string
+	Name      string
+	About     string
+	Title     string
+	Labels    []string
+	Assignees []string
+	Ref       string
+	Body      string
+	Fields    []*TemplateField
+	Errors    []string
+}
+
+// templateHeader is shared by markdown front matter and YAML forms.
+type templateHeader struct {
+	Name        string          `yaml:"name"`
+	About       string          `yaml:"about"`
+	Description string          `yaml:"description"`
+	Title       string          `yaml:"title"`
+	Labels      templateStrings `yaml:"labels"`
+	Assignees   templateStrings `yaml:"assignees"`
+	Ref         string          `yaml:"ref"`
+}
+
+type templateForm struct {
+	templateHeader `yaml:",inline"`
+	Body           []struct {
+		Type       string `yaml:"type"`
+		ID         string `yaml:"id"`
+		Attributes struct {
+			Label       string    `yaml:"label"`
+			Description string    `yaml:"description"`
+			Placeholder string    `yaml:"placeholder"`
+			Value       string    `yaml:"value"`
+			Render      string    `yaml:"render"`
+			Multiple    bool      `yaml:"multiple"`
+			Options     yaml.Node `yaml:"options"`
+		} `yaml:"attributes"`
+		Validations struct {
+			Required bool `yaml:"required"`
+		} `yaml:"validations"`
+	} `yaml:"body"`
+}
+
+// ParseIssueTemplate parses a markdown template with optional front matter
+// or a YAML issue form. It never fails; problems are collected in Errors.
+func ParseIssueTemplate(kind, filePath string, data []byte) *IssueTemplate {
+	t := &IssueTemplate{Kind: kind, Path: filePath}
+	ext := strings.ToLower(path.Ext(filePath))
+	if ext == ".md" {
+		t.parseMarkdown(string(data))
+	} else {
+		t.parseForm(data)
+	}
+	if t.Name == "" {
+		t.Name = strings.TrimSuffix(path.Base(filePath), path.Ext(filePath))
+	}
+	return t
+}
+
+func (t *IssueTemplate) setHeader(h templateHeader) {
+	t.Name = h.Name
+	t.About = cmp.Or(h.About, h.Description)
+	t.Title = h.Title
+	t.Labels = h.Labels
+	t.Assignees = h.Assignees
+	t.Ref = h.Ref
+}
+
+func (t *IssueTemplate) parseMarkdown(content string) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	front, body, ok := strings.Cut(strings.TrimPrefix(content, "---\n"), "\n---\n")
+	if !strings.HasPrefix(content, "---\n") || !ok {
+		t.Body = content
+		return
+	}
+	t.Body = strings.TrimLeft(body, "\n")
+
+	var h templateHeader
+	if err := yaml.Unmarshal([]byte(front), &h); err != nil {
+		t.Errors = append(t.Errors, "invalid front matter: "+strings.TrimPrefix(err.Error(), "yaml: "))
+		return
+	}
+	t.setHeader(h)
+}
+
+func (t *IssueTemplate) parseForm(data []byte) {
+	var form templateForm
+	if err := yaml.Unmarshal(data, &form); err != nil {
+		t.Errors = append(t.Errors, "invalid YAML: "+strings.TrimPrefix(err.Error(), "yaml: "))
+		return
+	}
+	t.setHeader(form.templateHeader)
+	if form.Name == "" {
+		t.Errors = append(t.Errors, "name is required")
+	}
+	if len(form.Body) == 0 {
+		t.Errors = append(t.Errors, "body must contain at least one field")
+	}
+
+	ids := map[string]bool{}
+	for i, e := range form.Body {
+		f := &TemplateField{
+			Type:        e.Type,
+			ID:          e.ID,
+			Label:       e.Attributes.Label,
+			Description: e.Attributes.Description,
+			Placeholder: e.Attributes.Placeholder,
+			Value:       e.Attributes.Value,
+			Multiple:    e.Attributes.Multiple,
+			Required:    e.Validations.Required,
+			Render:      e.Attributes.Render,
+		}
+		t.parseOptions(f, &e.Attributes.Options)
+
+		switch {
+		case !slices.Contains(templateFieldTypes, f.Type):
+			t.Errors = append(t.Errors, fmt.Sprintf("field %d: unknown type %q", i+1, f.Type))
+		case f.Type == "markdown":
+			if f.Value == "" {
+				t.Errors = append(t.Errors, fmt.Sprintf("field %d: markdown requires a value", i+1))
+			}
+		default:
+			if f.ID == "" {
+				// Forgejo names fields without an ID by position
+				f.ID = fmt.Sprintf("field%d", i)
+			}
+			if ids[f.ID] {
+				t.Errors = append(t.Errors, fmt.Sprintf("field %d: duplicate id %q", i+1, f.ID))
+			}
+			ids[f.ID] = true
+			if f.Label == "" {
+				t.Errors = append(t.Errors, fmt.Sprintf("field %q: label is required", f.ID))
+			}
+			if (f.Type == "dropdown" || f.Type == "checkboxes") && len(f.Options) == 0 {
+				t.Errors = append(t.Errors, fmt.Sprintf("field %q: options are required", f.ID))
+			}
+		}
+		t.Fields = append(t.Fields, f)
+	}
+}
+
+// parseOptions reads dropdown options (strings) and checkboxes (objects
+// with label and required).
+func (t *IssueTemplate) parseOptions(f *TemplateField, n *yaml.Node) {
+	for _, o := range n.Content {
+		if o.Kind == yaml.ScalarNode {
+			f.Options = append(f.Options, o.Value)
+			continue
+		}
+		var box struct {
+			Label    string `yaml:"label"`
+			Required bool   `yaml:"required"`
+		}
+		if err := o.Decode(&box); err != nil {
+			t.Errors = append(t.Errors, fmt.Sprintf("line %d: invalid option", o.Line))
+			continue
+		}
+		f.Options = append(f.Options, box.Label)
+		if box.Required {
+			f.RequiredOptions = append(f.RequiredOptions, box.Label)
+		}
+	}
+}
+
+// IsForm reports whether the template is a YAML issue form.
+func (t *IssueTemplate) IsForm() bool {
+	return len(t.Fields) > 0
+}
+
+// Matches reports whether name refers to this template, by its name, file
+// name with or without extension, or path. Case is ignored.
+func (t *IssueTemplate) Matches(name string) bool {
+	file := path.Base(t.Path)
+	return strings.EqualFold(name, t.Name) ||
+		strings.EqualFold(name, t.Path) ||
+		strings.EqualFold(name, file) ||
+		strings.EqualFold(name, strings.TrimSuffix(file, path.Ext(file)))
+}
+
+// RenderTitle applies the title prefix of the template to a title.
+func (t *IssueTemplate) RenderTitle(title string) string {
+	switch {
+	case title == "":
+		return strings.TrimSpace(t.Title)
+	case t.Title == "" || strings.HasPrefix(title, strings.TrimSpace(t.Title)):
+		return title
+	}
+	return t.Title + title
+}
+
+// RenderBody fills the template. A markdown template is used as is unless
+// body is given. Form values are validated against the fields and rendered
+// under their labels, like the web interface does. Values are strings, or
+// lists of strings for multiple choice dropdowns and checkboxes.
+func (t *IssueTemplate) RenderBody(body string, values map[string]any) (string, error) {
+	if !t.IsForm() {
+		if len(values) > 0 {
+			return "", fmt.Errorf("template %q has no form fields", t.Name)
+		}
+		return cmp.Or(body, t.Body), nil
+	}
+
+	var problems []string
+	known := map[string]bool{}
+	markdown := ""
+	for _, f := range t.Fields {
+		if f.Type == "markdown" {
+			continue
+		}
+		known[f.ID] = true
+		selected, err := fieldValues(values[f.ID])
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", f.ID, err))
+			continue
+		}
+		if problem := f.validate(selected); problem != "" {
+			problems = append(problems, problem)
+			continue
+		}
+		markdown += f.render(selected)
+	}
+	for id := range values {
+		if !known[id] {
+			problems = append(problems, fmt.Sprintf("%s: no such field", id))
+		}
+	}
+	if len(problems) > 0 {
+		slices.Sort(problems)
+		return "", errors.New("invalid template fields: " + strings.Join(problems, "; "))
+	}
+
+	if body != "" {
+		markdown += body + "\n"
+	}
+	return strings.TrimSuffix(markdown, "\n"), nil
+}
+
+func fieldValues(v any) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		return []string{v}, nil
+	case float64:
+		return []string{fmt.Sprint(v)}, nil
+	case bool:
+		return []string{fmt.Sprint(v)}, nil
+	case []any:
+		var ret []string
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, errors.New("list items must be strings")
+			}
+			ret = append(ret, s)
+		}
+		return ret, nil
+	}
+	return nil, fmt.Errorf("unsupported value type %T", v)
+}
+
+// validate returns a problem description, or "" if the values are valid.
+func (f *TemplateField) validate(values []string) string {
+	switch f.Type {
+	case "dropdown":
+		if len(values) > 1 && !f.Multiple {
+			return fmt.Sprintf("%s: only one option may be selected", f.ID)
+		}
+		fallthrough
+	case "checkboxes":
+		for _, v := range values {
+			if !slices.Contains(f.Options, v) {
+				return fmt.Sprintf("%s: %q is not one of %s", f.ID, v, strings.Join(f.Options, ", "))
+			}
+		}
+		for _, o := range f.RequiredOptions {
+			if !slices.Contains(values, o) {
+				return fmt.Sprintf("%s: %q must be checked", f.ID, o)
+			}
+		}
+	default:
+		if len(values) > 1 {
+			return fmt.Sprintf("%s: expected a single value", f.ID)
+		}
+	}
+	if f.Required && len(values) == 0 {
+		return fmt.Sprintf("%s: %q is required", f.ID, f.Label)
+	}
+	return ""
+}
+
+func (f *TemplateField) render(values []string) string {
+	markdown := "### " + f.Label + "\n\n"
+	switch {
+	case f.Type == "checkboxes":
+		for _, o := range f.Options {
+			mark := " "
+			if slices.Contains(values, o) {
+				mark = "x"
+			}
+			markdown += fmt.Sprintf("- [%s] %s\n", mark, o)
+		}
+	case len(values) == 0:
+		markdown += "_No response_\n"
+	case f.Render != "":
+		markdown += "```" + f.Render + "\n" + values[0] + "\n```\n"
+	default:
+		markdown += strings.Join(values, ", ") + "\n"
+	}
+	return markdown + "\n"
+}
+
+// ToMarkdown renders the template header, its form fields and body
+// Example:
+// ## Bug report (issue template, form)
+// File: `.forgejo/ISSUE_TEMPLATE/bug.yaml`
+// About: Something does not work
+// Title prefix: `[Bug]: `
+// Labels: bug
+//
+// ### Fields
+// - `what` (textarea, required): What happened?
+// - `version` (dropdown): Version - options: 1.0, 2.0
+func (t *IssueTemplate) ToMarkdown() string {
+	kind := "issue template"
+	if t.Kind == TemplateKindPullRequest {
+		kind = "pull request template"
+	}
+	if t.IsForm() {
+		kind += ", form"
+	}
+	markdown := fmt.Sprintf("## %s (%s)\nFile: `%s`\n", t.Name, kind, t.Path)
+	if t.About != "" {
+		markdown += "About: " + t.About + "\n"
+	}
+	if t.Title != "" {
+		markdown += "Title prefix: `" + t.Title + "`\n"
+	}
+	if len(t.Labels) > 0 {
+		markdown += "Labels: " + strings.Join(t.Labels, ", ") + "\n"
+	}
+	if len(t.Assignees) > 0 {
+		markdown += "Assignees: @" + strings.Join(t.Assignees, ", @") + "\n"
+	}
+	if t.Ref != "" {
+		markdown += "Ref: " + t.Ref + "\n"
+	}
+
+	if t.IsForm() {
+		markdown += "\n### Fields\n"
+		for _, f := range t.Fields {
+			if f.Type == "markdown" {
+				continue
+			}
+			attrs := f.Type
+			if f.Required {
+				attrs += ", required"
+			}
+			if f.Multiple {
+				attrs += ", multiple"
+			}
+			markdown += fmt.Sprintf("- `%s` (%s): %s", f.ID, attrs, f.Label)
+			if f.Description != "" {
+				markdown += " - " + f.Description
+			}
+			if len(f.Options) > 0 {
+				markdown += " - options: " + strings.Join(f.Options, ", ")
+			}
+			if len(f.RequiredOptions) > 0 {
+				markdown += " (must check: " + strings.Join(f.RequiredOptions, ", ") + ")"
+			}
+			markdown += "\n"
+		}
+	} else if t.Body != "" {
+		markdown += "\n### Body\n" + t.Body
+		if !strings.HasSuffix(t.Body, "\n") {
+			markdown += "\n"
+		}
+	}
+
+	if len(t.Errors) > 0 {
+		markdown += "\n### Problems\n"
+		for _, e := range t.Errors {
+			markdown += "- " + e + "\n"
+		}
+	}
+	return markdown
+}
+
+// IssueTemplateList represents the templates found in a repository
+type IssueTemplateList []*IssueTemplate
+
+// ToMarkdown renders one line per template with its fields
+// Example:
+// - **Bug report** (issue, form) `.forgejo/ISSUE_TEMPLATE/bug.yaml` - Something does not work; fields: what*, version
+// - **Pull request** (pull_request) `.forgejo/PULL_REQUEST_TEMPLATE.md`
+func (tl IssueTemplateList) ToMarkdown() string {
+	if len(tl) == 0 {
+		return "*No templates found*"
+	}
+	markdown := ""
+	for _, t := range tl {
+		kind := t.Kind
+		if t.IsForm() {
+			kind += ", form"
+		}
+		markdown += fmt.Sprintf("- **%s** (%s) `%s`", t.Name, kind, t.Path)
+		if t.About != "" {
+			markdown += " - " + t.About
+		}
+		var fields []string
+		for _, f := range t.Fields {
+			if f.Type == "markdown" {
+				continue
+			}
+			if f.Required || len(f.RequiredOptions) > 0 {
+				fields = append(fields, f.ID+"*")
+			} else {
+				fields = append(fields, f.ID)
+			}
+		}
+		if len(fields) > 0 {
+			markdown += "; fields: " + strings.Join(fields, ", ")
+		}
+		if len(t.Errors) > 0 {
+			markdown += fmt.Sprintf(" (%d problems)", len(t.Errors))
+		}
+		markdown += "\n"
+	}
+	return markdown
+}
@@ -0,0 +1,268 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+// MyTimelineComment represents one event of an issue timeline, which is not
+// provided by the SDK. Type tells what happened, the other fields are set
+// depending on it.
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/issues/{index}/timeline
+//
+// Example:
+//
+//	{"id": 7, "type": "label", "user": {"login": "alice"}, "body": "1",
+//	 "label": {"name": "bug"}, "created_at": "2024-01-15T14:30:00Z"}
+type MyTimelineComment struct {
+	ID              int64              `json:"id"`
+	Type            string             `json:"type"`
+	HTMLURL         string             `json:"html_url"`
+	Poster          *forgejo.User      `json:"user"`
+	Body            string             `json:"body"`
+	Created         time.Time          `json:"created_at"`
+	Updated         time.Time          `json:"updated_at"`
+	OldMilestone    *forgejo.Milestone `json:"old_milestone"`
+	Milestone       *forgejo.Milestone `json:"milestone"`
+	OldTitle        string             `json:"old_title"`
+	NewTitle        string             `json:"new_title"`
+	OldRef          string             `json:"old_ref"`
+	NewRef          string             `json:"new_ref"`
+	RefIssue        *forgejo.Issue     `json:"ref_issue"`
+	RefAction       string             `json:"ref_action"`
+	RefCommitSHA    string             `json:"ref_commit_sha"`
+	ReviewID        int64              `json:"review_id"`
+	Label           *forgejo.Label     `json:"label"`
+	Assignee        *forgejo.User      `json:"assignee"`
+	AssigneeTeam    *forgejo.Team      `json:"assignee_team"`
+	RemovedAssignee bool               `json:"removed_assignee"`
+	DependentIssue  *forgejo.Issue     `json:"dependent_issue"`
+}
+
+// Describe returns what happened in a short sentence without the actor
+// Example: added label **bug**
+func (c *MyTimelineComment) Describe() string {
+	switch c.Type {
+	case "comment":
+		return "commented"
+	case "close":
+		if c.RefCommitSHA != "" {
+			return "closed this in commit " + shortSHA(c.RefCommitSHA)
+		}
+		return "closed this"
+	case "reopen":
+		return "reopened this"
+	case "merge_pull":
+		if c.RefCommitSHA != "" {
+			return "merged this in commit " + shortSHA(c.RefCommitSHA)
+		}
+		return "merged this"
+	case "label":
+		name := "(deleted label)"
+		if c.Label != nil {
+			name = c.Label.Name
+		}
+		if c.Body == "1" {
+			return "added label **" + name + "**"
+		}
+		return "removed label **" + name + "**"
+	case "milestone":
+		switch {
+		case c.OldMilestone != nil && c.Milestone != nil:
+			return fmt.Sprintf("moved this from milestone **%s** to **%s**", c.OldMilestone.Title, c.Milestone.Title)
+		case c.Milestone != nil:
+			return "added this to milestone **" + c.Milestone.Title + "**"
+		case c.OldMilestone != nil:
+			return "removed this from milestone **" + c.OldMilestone.Title + "**"
+		}
+		return "changed the milestone"
+	case "assignees":
+		who := c.assigneeName()
+		switch {
+		case c.RemovedAssignee:
+			return "unassigned " + who
+		case c.Poster != nil && c.Assignee != nil && c.Poster.ID == c.Assignee.ID:
+			return "self-assigned this"
+		}
+		return "assigned " + who
+	case "review_request":
+		if c.RemovedAssignee {
+			return "removed the review request for " + c.assigneeName()
+		}
+		return "requested a review from " + c.assigneeName()
+	case "change_title":
+		return fmt.Sprintf("changed the title from **%s** to **%s**", c.OldTitle, c.NewTitle)
+	case "commit_ref":
+		return "referenced this in commit " + shortSHA(c.RefCommitSHA)
+	case "issue_ref", "pull_ref", "comment_ref":
+		return c.describeRef()
+	case "change_issue_ref":
+		return fmt.Sprintf("changed the reference from `%s` to `%s`", c.OldRef, c.NewRef)
+	case "review":
+		return fmt.Sprintf("reviewed (review #%d)", c.ReviewID)
+	case "code":
+		return "commented on the code"
+	case "dismiss_review":
+		return "dismissed a review"
+	case "pull_push":
+		return c.describePush()
+	case "delete_branch":
+		return "deleted branch `" + c.OldRef + "`"
+	case "change_target_branch":
+		return fmt.Sprintf("changed the target branch from `%s` to `%s`", c.OldRef, c.NewRef)
+	case "lock":
+		if c.Body != "" {
+			return "locked this as **" + c.Body + "**"
+		}
+		return "locked this"
+	case "unlock":
+		return "unlocked this"
+	case "pin":
+		return "pinned this"
+	case "unpin":
+		return "unpinned this"
+	case "added_deadline":
+		return "set the due date to " + c.Body
+	case "modified_deadline":
+		if newDate, oldDate, ok := strings.Cut(c.Body, "|"); ok {
+			return fmt.Sprintf("changed the due date from %s to %s", oldDate, newDate)
+		}
+		return "changed the due date to " + c.Body
+	case "removed_deadline":
+		return "removed the due date " + c.Body
+	case "add_dependency":
+		return "added dependency " + refIssueName(c.DependentIssue)
+	case "remove_dependency":
+		return "removed dependency " + refIssueName(c.DependentIssue)
+	case "start_tracking":
+		return "started working"
+	case "stop_tracking":
+		return "stopped working"
+	case "cancel_tracking":
+		return "cancelled time tracking"
+	case "add_time_manual":
+		return "added spent time"
+	case "delete_time_manual":
+		return "deleted spent time"
+	case "pull_scheduled_merge":
+		return "scheduled this to merge when checks succeed"
+	case "pull_cancel_scheduled_merge":
+		return "cancelled the scheduled merge"
+	case "project", "project_board":
+		return "changed the project"
+	}
+	return strings.ReplaceAll(c.Type, "_", " ")
+}
+
+func (c *MyTimelineComment) assigneeName() string {
+	switch {
+	case c.Assignee != nil:
+		return "@" + c.Assignee.UserName
+	case c.AssigneeTeam != nil:
+		return "team " + c.AssigneeTeam.Name
+	}
+	return "someone"
+}
+
+func (c *MyTimelineComment) describeRef() string {
+	kind := "issue"
+	if c.RefIssue != nil && c.RefIssue.PullRequest != nil {
+		kind = "pull request"
+	}
+	desc := "mentioned this in " + kind + " " + refIssueName(c.RefIssue)
+	switch c.RefAction {
+	case "closes":
+		desc += " (will close this)"
+	case "reopens":
+		desc += " (will reopen this)"
+	}
+	return desc
+}
+
+func (c *MyTimelineComment) describePush() string {
+	var push struct {
+		IsForcePush bool     `json:"is_force_push"`
+		CommitIDs   []string `json:"commit_ids"`
+	}
+	if json.Unmarshal([]byte(c.Body), &push) != nil {
+		return "pushed commits"
+	}
+	if push.IsForcePush {
+		return "force-pushed"
+	}
+	return fmt.Sprintf("pushed %d commits", len(push.CommitIDs))
+}
+
+// refIssueName renders a referenced issue as owner/repo#index and title.
+func refIssueName(issue *forgejo.Issue) string {
+	if issue == nil {
+		return "(unknown)"
+	}
+	name := fmt.Sprintf("#%d", issue.Index)
+	if issue.Repository != nil && issue.Repository.FullName != "" {
+		name = issue.Repository.FullName + name
+	}
+	return name + " " + issue.Title
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 8 {
+		sha = sha[:8]
+	}
+	return "`" + sha + "`"
+}
+
+// IssueTimeline represents the full event history of an issue
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/issues/{index}/timeline
+type IssueTimeline []*MyTimelineComment
+
+// ToMarkdown renders events in chronological order, quoting comment and
+// review bodies below their event
+// Example:
+// - 2024-01-15 14:30 @alice added label **bug**
+// - 2024-01-15 14:45 @bob commented (Comment#12)
+// - 2024-01-16 09:10 @bob closed this in commit `a1b2c3d4`
+func (tl IssueTimeline) ToMarkdown() string {
+	if len(tl) == 0 {
+		return "*No events found*"
+	}
+	events := slices.Clone(tl)
+	slices.SortStableFunc(events, func(a, b *MyTimelineComment) int {
+		return cmp.Or(a.Created.Compare(b.Created), cmp.Compare(a.ID, b.ID))
+	})
+
+	markdown := ""
+	for _, c := range events {
+		actor := "someone"
+		if c.Poster != nil {
+			actor = "@" + c.Poster.UserName
+		}
+		markdown += fmt.Sprintf("- %s %s %s", c.Created.Format("2006-01-02 15:04"), actor, c.Describe())
+		if c.Type == "comment" {
+			markdown += fmt.Sprintf(" (Comment#%d)", c.ID)
+		}
+		markdown += "\n"
+
+		switch c.Type {
+		case "comment", "review", "code", "dismiss_review", "close", "reopen":
+			if body := strings.TrimSpace(c.Body); body != "" {
+				markdown += "  > " + strings.ReplaceAll(body, "\n", "\n  > ") + "\n"
+			}
+		}
+	}
+	return markdown
+}
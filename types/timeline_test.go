@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+func TestMyTimelineComment_Describe(t *testing.T) {
+	alice := &forgejo.User{ID: 1, UserName: "alice"}
+	bob := &forgejo.User{ID: 2, UserName: "bob"}
+	tests := []struct {
+		name  string
+		event MyTimelineComment
+		want  string
+	}{
+		{"label added", MyTimelineComment{Type: "label", Body: "1", Label: &forgejo.Label{Name: "bug"}}, "added label **bug**"},
+		{"label removed", MyTimelineComment{Type: "label", Label: &forgejo.Label{Name: "bug"}}, "removed label **bug**"},
+		{"assigned", MyTimelineComment{Type: "assignees", Poster: alice, Assignee: bob}, "assigned @bob"},
+		{"self assigned", MyTimelineComment{Type: "assignees", Poster: alice, Assignee: alice}, "self-assigned this"},
+		{"unassigned", MyTimelineComment{Type: "assignees", Poster: alice, Assignee: bob, RemovedAssignee: true}, "unassigned @bob"},
+		{
+			"milestone moved",
+			MyTimelineComment{Type: "milestone", OldMilestone: &forgejo.Milestone{Title: "v1.0"}, Milestone: &forgejo.Milestone{Title: "v1.1"}},
+			"moved this from milestone **v1.0** to **v1.1**",
+		},
+		{"closed by commit", MyTimelineComment{Type: "close", RefCommitSHA: "a1b2c3d4e5f6"}, "closed this in commit `a1b2c3d4`"},
+		{"title", MyTimelineComment{Type: "change_title", OldTitle: "Login bug", NewTitle: "Fix login"}, "changed the title from **Login bug** to **Fix login**"},
+		{
+			"pull ref",
+			MyTimelineComment{
+				Type:      "pull_ref",
+				RefAction: "closes",
+				RefIssue: &forgejo.Issue{
+					Index:       7,
+					Title:       "Fix login",
+					PullRequest: &forgejo.PullRequestMeta{},
+					Repository:  &forgejo.RepositoryMeta{FullName: "org/project"},
+				},
+			},
+			"mentioned this in pull request org/project#7 Fix login (will close this)",
+		},
+		{"force push", MyTimelineComment{Type: "pull_push", Body: `{"is_force_push":true}`}, "force-pushed"},
+		{"push", MyTimelineComment{Type: "pull_push", Body: `{"commit_ids":["a","b"]}`}, "pushed 2 commits"},
+		{"deadline", MyTimelineComment{Type: "modified_deadline", Body: "2024-02-01|2024-01-20"}, "changed the due date from 2024-01-20 to 2024-02-01"},
+		{"unknown", MyTimelineComment{Type: "some_new_event"}, "some new event"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.Describe(); got != tt.want {
+				t.Errorf("Describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueTimeline_ToMarkdown(t *testing.T) {
+	created := testTime()
+	timeline := IssueTimeline{
+		{ID: 3, Type: "close", Poster: testUser(), Created: created.Add(2 * time.Hour)},
+		{ID: 1, Type: "label", Body: "1", Poster: testUser(), Label: &forgejo.Label{Name: "bug"}, Created: created},
+		{ID: 2, Type: "comment", Body: "Duplicate of #12\nClosing.", Poster: testUser(), Created: created.Add(time.Hour)},
+	}
+
+	output := timeline.ToMarkdown()
+	assertContains(t, output, []string{
+		"- 2024-01-15 14:30 @testuser added label **bug**",
+		"- 2024-01-15 15:30 @testuser commented (Comment#2)",
+		"  > Duplicate of #12\n  > Closing.",
+		"- 2024-01-15 16:30 @testuser closed this",
+	})
+
+	label := strings.Index(output, "added label")
+	comment := strings.Index(output, "commented")
+	closed := strings.Index(output, "closed this")
+	if !(label < comment && comment < closed) {
+		t.Errorf("events are not in chronological order:\n%s", output)
+	}
+	if timeline[0].ID != 3 {
+		t.Error("ToMarkdown must not reorder the original slice")
+	}
+
+	if got := (IssueTimeline{}).ToMarkdown(); got != "*No events found*" {
+		t.Errorf("empty timeline = %q", got)
+	}
+}
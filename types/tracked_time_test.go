@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+func TestFormatSeconds(t *testing.T) {
+	tests := map[int64]string{
+		20:    "20s",
+		900:   "15m",
+		5400:  "1h30m",
+		36060: "10h01m",
+	}
+	for in, want := range tests {
+		if got := FormatSeconds(in); got != want {
+			t.Errorf("FormatSeconds(%d) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestTrackedTimeList_ToMarkdown(t *testing.T) {
+	issue := &forgejo.Issue{Index: 42, Title: "Fix login"}
+	tests := []struct {
+		name     string
+		list     TrackedTimeList
+		required []string
+	}{
+		{
+			name: "entries with total",
+			list: TrackedTimeList{
+				{ID: 15, Created: testTime(), UserName: "alice", Time: 5400, Issue: issue},
+				{ID: 16, Created: testTime().AddDate(0, 0, 1), UserName: "bob", Time: 2700, Issue: issue},
+			},
+			required: []string{
+				"- `#15` 2024-01-15 @alice **1h30m** on #42 Fix login",
+				"- `#16` 2024-01-16 @bob **45m** on #42 Fix login",
+				"Total: 2h15m",
+			},
+		},
+		{
+			name:     "empty list",
+			list:     TrackedTimeList{},
+			required: []string{"No tracked times found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertContains(t, tt.list.ToMarkdown(), tt.required)
+		})
+	}
+}
+
+func TestStopWatchList_ToMarkdown(t *testing.T) {
+	list := StopWatchList{{Created: testTime(), Seconds: 1500, IssueIndex: 42, IssueTitle: "Fix login", RepoOwnerName: "org", RepoName: "project"}}
+	assertContains(t, list.ToMarkdown(), []string{"- org/project#42 Fix login - running 25m (since 2024-01-15 14:30)"})
+	assertContains(t, StopWatchList{}.ToMarkdown(), []string{"No running stopwatches"})
+}
+
+func TestBuildTimeReport(t *testing.T) {
+	v1 := &forgejo.Milestone{Title: "v1.0"}
+	byRepo := map[string][]*forgejo.TrackedTime{
+		"org/api": {
+			{UserName: "alice", Time: 3600, Issue: &forgejo.Issue{Index: 1, Title: "Login", Milestone: v1}},
+			{UserName: "bob", Time: 1800, Issue: &forgejo.Issue{Index: 2, Title: "Logout"}},
+		},
+		"org/web": {
+			{UserName: "alice", Time: 7200, Issue: &forgejo.Issue{Index: 7, Title: "Theme", Milestone: v1}},
+		},
+	}
+	since := testTime()
+	before := since.Add(30 * 24 * time.Hour)
+
+	tests := []struct {
+		groupBy  string
+		required []string
+	}{
+		{
+			groupBy: "user",
+			required: []string{
+				"## Time by user (2024-01-15 – 2024-02-14)",
+				"| User | Entries | Time | Share |",
+				"| @alice | 2 | 3h00m | 85.7% |",
+				"| @bob | 1 | 30m | 14.3% |",
+				"| **Total** | 3 | 3h30m | 100% |",
+			},
+		},
+		{
+			groupBy:  "milestone",
+			required: []string{"| v1.0 | 2 | 3h00m |", "| (no milestone) | 1 | 30m |"},
+		},
+		{
+			groupBy:  "repo",
+			required: []string{"| org/web | 1 | 2h00m |", "| org/api | 2 | 1h30m |"},
+		},
+		{
+			groupBy:  "issue",
+			required: []string{"| org/web#7 Theme | 1 | 2h00m |"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.groupBy, func(t *testing.T) {
+			report := BuildTimeReport(byRepo, tt.groupBy, since, before)
+			assertContains(t, report.ToMarkdown(), tt.required)
+		})
+	}
+
+	empty := BuildTimeReport(nil, "", time.Time{}, time.Time{})
+	assertContains(t, empty.ToMarkdown(), []string{"## Time by user", "No tracked time in this period"})
+}
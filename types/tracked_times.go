@@ -0,0 +1,204 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v2"
+)
+
+// FormatSeconds renders a tracked duration as hours and minutes
+// Example: 5400 -> 1h30m, 900 -> 15m, 20 -> 20s
+func FormatSeconds(seconds int64) string {
+	d := time.Duration(seconds) * time.Second
+	switch {
+	case d < time.Minute:
+		return d.String()
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int64(d/time.Minute))
+	}
+	return fmt.Sprintf("%dh%02dm", int64(d/time.Hour), int64(d%time.Hour/time.Minute))
+}
+
+// TrackedTime represents a tracked time response with embedded SDK tracked time
+// Used by endpoints:
+// - POST /repos/{owner}/{repo}/issues/{index}/times
+type TrackedTime struct {
+	*forgejo.TrackedTime
+}
+
+// ToMarkdown renders a tracked time entry with ID, date, user, duration and issue
+// Example: `#15` 2024-01-15 @alice **1h30m** on #42 Fix login
+func (t *TrackedTime) ToMarkdown() string {
+	if t.TrackedTime == nil {
+		return "*Invalid tracked time*"
+	}
+	markdown := fmt.Sprintf("`#%d` %s @%s **%s**", t.ID, t.Created.Format("2006-01-02"), t.UserName, FormatSeconds(t.Time))
+	if t.Issue != nil {
+		markdown += fmt.Sprintf(" on #%d %s", t.Issue.Index, t.Issue.Title)
+	}
+	return markdown
+}
+
+// TrackedTimeList represents a list of tracked times response
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/issues/{index}/times
+// - GET /repos/{owner}/{repo}/times
+type TrackedTimeList []*forgejo.TrackedTime
+
+// ToMarkdown renders tracked times as a bullet list followed by the total
+// Example:
+// - `#15` 2024-01-15 @alice **1h30m** on #42 Fix login
+// - `#16` 2024-01-16 @bob **45m** on #42 Fix login
+//
+// Total: 2h15m
+func (tl TrackedTimeList) ToMarkdown() string {
+	if len(tl) == 0 {
+		return "*No tracked times found*"
+	}
+	markdown := ""
+	var total int64
+	for _, t := range tl {
+		markdown += "- " + (&TrackedTime{TrackedTime: t}).ToMarkdown() + "\n"
+		total += t.Time
+	}
+	return markdown + "\nTotal: " + FormatSeconds(total) + "\n"
+}
+
+// StopWatchList represents a list of running stopwatches response
+// Used by endpoints:
+// - GET /user/stopwatches
+type StopWatchList []*forgejo.StopWatch
+
+// ToMarkdown renders running stopwatches as a bullet list
+// Example:
+// - org/project#42 Fix login - running 25m (since 2024-01-15 14:30)
+func (sl StopWatchList) ToMarkdown() string {
+	if len(sl) == 0 {
+		return "*No running stopwatches*"
+	}
+	markdown := ""
+	for _, s := range sl {
+		markdown += fmt.Sprintf("- %s/%s#%d %s - running %s (since %s)\n",
+			s.RepoOwnerName, s.RepoName, s.IssueIndex, s.IssueTitle,
+			FormatSeconds(s.Seconds), s.Created.Format("2006-01-02 15:04"))
+	}
+	return markdown
+}
+
+// TimeReportGroups are the supported groupings of a time report.
+var TimeReportGroups = []string{"user", "milestone", "repo", "issue"}
+
+// TimeReportRow is the time spent in one group of a time report.
+type TimeReportRow struct {
+	Key     string
+	Entries int
+	Seconds int64
+}
+
+// TimeReport aggregates tracked time over a date range.
+type TimeReport struct {
+	GroupBy string
+	Since   time.Time
+	Before  time.Time
+	Rows    []*TimeReportRow
+	Total   int64
+}
+
+// BuildTimeReport sums the tracked times of one or more repositories, keyed
+// by repository full name, grouped by user (default), milestone, repo or
+// issue. Rows are sorted by time spent, largest first.
+func BuildTimeReport(byRepo map[string][]*forgejo.TrackedTime, groupBy string, since, before time.Time) *TimeReport {
+	if !slices.Contains(TimeReportGroups, groupBy) {
+		groupBy = "user"
+	}
+	report := &TimeReport{GroupBy: groupBy, Since: since, Before: before}
+	rows := map[string]*TimeReportRow{}
+	for repo, times := range byRepo {
+		for _, t := range times {
+			key := timeReportKey(repo, t, groupBy)
+			row, ok := rows[key]
+			if !ok {
+				row = &TimeReportRow{Key: key}
+				rows[key] = row
+				report.Rows = append(report.Rows, row)
+			}
+			row.Entries++
+			row.Seconds += t.Time
+			report.Total += t.Time
+		}
+	}
+	slices.SortFunc(report.Rows, func(a, b *TimeReportRow) int {
+		return cmp.Or(cmp.Compare(b.Seconds, a.Seconds), cmp.Compare(a.Key, b.Key))
+	})
+	return report
+}
+
+func timeReportKey(repo string, t *forgejo.TrackedTime, groupBy string) string {
+	switch groupBy {
+	case "milestone":
+		if t.Issue == nil || t.Issue.Milestone == nil {
+			return "(no milestone)"
+		}
+		return t.Issue.Milestone.Title
+	case "repo":
+		return repo
+	case "issue":
+		if t.Issue == nil {
+			return repo + "#?"
+		}
+		return fmt.Sprintf("%s#%d %s", repo, t.Issue.Index, t.Issue.Title)
+	default:
+		return "@" + t.UserName
+	}
+}
+
+// ToMarkdown renders the report as a table with share of the total
+// Example:
+// ## Time by user (2024-01-01 – 2024-01-31)
+//
+// | User | Entries | Time | Share |
+// |------|---------|------|-------|
+// | @alice | 12 | 20h30m | 75.0% |
+// | @bob | 4 | 6h50m | 25.0% |
+// | **Total** | 16 | 27h20m | 100% |
+func (r *TimeReport) ToMarkdown() string {
+	markdown := "## Time by " + r.GroupBy
+	switch {
+	case !r.Since.IsZero() && !r.Before.IsZero():
+		markdown += fmt.Sprintf(" (%s – %s)", r.Since.Format("2006-01-02"), r.Before.Format("2006-01-02"))
+	case !r.Since.IsZero():
+		markdown += " (since " + r.Since.Format("2006-01-02") + ")"
+	case !r.Before.IsZero():
+		markdown += " (before " + r.Before.Format("2006-01-02") + ")"
+	}
+	markdown += "\n\n"
+
+	if len(r.Rows) == 0 {
+		return markdown + "*No tracked time in this period*\n"
+	}
+
+	header := strings.ToUpper(r.GroupBy[:1]) + r.GroupBy[1:]
+	markdown += "| " + header + " | Entries | Time | Share |\n"
+	markdown += "|------|---------|------|-------|\n"
+	entries := 0
+	for _, row := range r.Rows {
+		share := 0.0
+		if r.Total > 0 {
+			share = float64(row.Seconds) * 100 / float64(r.Total)
+		}
+		markdown += fmt.Sprintf("| %s | %d | %s | %.1f%% |\n", row.Key, row.Entries, FormatSeconds(row.Seconds), share)
+		entries += row.Entries
+	}
+	markdown += fmt.Sprintf("| **Total** | %d | %s | 100%% |\n", entries, FormatSeconds(r.Total))
+	return markdown
+}
@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import "testing"
+
+func TestActionVariable_ToMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		variable *ActionVariable
+		required []string
+	}{
+		{
+			name:     "variable with value",
+			variable: &ActionVariable{MyActionVariable: &MyActionVariable{Name: "DEPLOY_HOST", Data: "example.com"}},
+			required: []string{"**DEPLOY_HOST**", "`example.com`"},
+		},
+		{
+			name:     "nil variable",
+			variable: &ActionVariable{},
+			required: []string{"Invalid variable"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := tt.variable.ToMarkdown()
+			assertContains(t, output, tt.required)
+		})
+	}
+}
+
+func TestActionVariableList_ToMarkdown(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables ActionVariableList
+		required  []string
+	}{
+		{
+			name: "multiple variables",
+			variables: ActionVariableList{
+				{Name: "DEPLOY_HOST", Data: "example.com"},
+				{Name: "GO_VERSION", Data: "1.24"},
+			},
+			required: []string{"- **DEPLOY_HOST** = `example.com`", "- **GO_VERSION** = `1.24`"},
+		},
+		{
+			name:      "empty list",
+			variables: ActionVariableList{},
+			required:  []string{"No variables found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := tt.variables.ToMarkdown()
+			assertContains(t, output, tt.required)
+		})
+	}
+}
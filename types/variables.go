@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// MyActionVariable represents a Forgejo Actions variable.
+type MyActionVariable struct {
+	OwnerID int64  `json:"owner_id"`
+	RepoID  int64  `json:"repo_id"`
+	Name    string `json:"name"`
+	Data    string `json:"data"`
+}
+
+// MyActionVariableOption represents options for creating or updating a variable.
+type MyActionVariableOption struct {
+	Name  string `json:"name,omitempty"` // new name, update only
+	Value string `json:"value"`
+}
+
+// MyActionSecret represents a Forgejo Actions secret as listed by the API;
+// its value is never returned.
+type MyActionSecret struct {
+	Name    string    `json:"name"`
+	Created time.Time `json:"created_at"`
+}
+
+// MyActionSecretOption represents options for creating or updating a secret.
+type MyActionSecretOption struct {
+	Data string `json:"data"`
+}
+
+// ActionVariable represents a variable response
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/actions/variables/{name}
+// - GET /orgs/{org}/actions/variables/{name}
+// - GET /user/actions/variables/{name}
+type ActionVariable struct {
+	*MyActionVariable
+}
+
+// ToMarkdown renders variable name and value
+// Example: **DEPLOY_HOST** = `example.com`
+func (v *ActionVariable) ToMarkdown() string {
+	if v.MyActionVariable == nil {
+		return "*Invalid variable*"
+	}
+	return fmt.Sprintf("**%s** = `%s`", v.Name, v.Data)
+}
+
+// ActionVariableList represents a list of variables response
+// Used by endpoints:
+// - GET /repos/{owner}/{repo}/actions/variables
+// - GET /orgs/{org}/actions/variables
+// - GET /user/actions/variables
+type ActionVariableList []*MyActionVariable
+
+// ToMarkdown renders variables as a bullet list
+// Example:
+// - **DEPLOY_HOST** = `example.com`
+// - **GO_VERSION** = `1.24`
+func (vl ActionVariableList) ToMarkdown() string {
+	if len(vl) == 0 {
+		return "*No variables found*"
+	}
+	markdown := ""
+	for _, v := range vl {
+		markdown += "- " + (&ActionVariable{MyActionVariable: v}).ToMarkdown() + "\n"
+	}
+	return markdown
+}
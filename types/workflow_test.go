@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseWorkflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		errors   int
+		required []string
+	}{
+		{
+			name: "valid workflow",
+			content: `name: CI
+on:
+  push:
+    branches: [main]
+  pull_request:
+jobs:
+  build:
+    runs-on: docker
+    steps:
+      - uses: actions/checkout@v4
+      - run: go build ./...
+  test:
+    runs-on: [docker, amd64]
+    needs: build
+    steps:
+      - run: go test ./...
+`,
+			required: []string{
+				"### .forgejo/workflows/ci.yml (CI)",
+				"Triggers: push, pull_request",
+				"- **build** runs-on: docker",
+				"- **test** runs-on: docker, amd64 | needs: build",
+				"No problems found.",
+			},
+		},
+		{
+			name: "schema errors with line numbers",
+			content: `on: push
+jobs:
+  build:
+    steps:
+      - run: make
+        uses: actions/checkout@v4
+  test:
+    runs-on: docker
+    needs: [biuld]
+    step:
+      - run: make test
+`,
+			errors: 5,
+			required: []string{
+				"line 3: job 'build' is missing 'runs-on'",
+				"line 5: step in job 'build' cannot have both 'run' and 'uses'",
+				"line 10: unknown key 'step' in job 'test'",
+				"line 7: job 'test' has no steps",
+				"line 9: job 'test' needs unknown job 'biuld'",
+			},
+		},
+		{
+			name: "reusable workflow and missing trigger",
+			content: `jobs:
+  call:
+    uses: ./.forgejo/workflows/build.yml
+`,
+			errors: 1,
+			required: []string{
+				"- **call** uses: ./.forgejo/workflows/build.yml",
+				"line 1: missing 'on' (workflow triggers)",
+			},
+		},
+		{
+			name:     "invalid yaml",
+			content:  "on: push\njobs:\n  build:\n    runs-on: [docker\n",
+			errors:   1,
+			required: []string{"invalid YAML"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := ParseWorkflow(".forgejo/workflows/ci.yml", []byte(tt.content))
+			if len(w.Errors) != tt.errors {
+				t.Errorf("expected %d errors, got %d: %s", tt.errors, len(w.Errors), w.ToMarkdown())
+			}
+			assertContains(t, w.ToMarkdown(), tt.required)
+		})
+	}
+}
+
+func TestWorkflowList_ToMarkdown(t *testing.T) {
+	assertContains(t, WorkflowList{}.ToMarkdown(), []string{"No workflow files found"})
+	list := WorkflowList{
+		ParseWorkflow(".forgejo/workflows/a.yml", []byte("on: push\njobs:\n  a:\n    runs-on: docker\n    steps:\n      - run: true\n")),
+		ParseWorkflow(".github/workflows/b.yml", []byte("on: push\n")),
+	}
+	assertContains(t, list.ToMarkdown(), []string{"### .forgejo/workflows/a.yml", "### .github/workflows/b.yml", "missing 'jobs'"})
+	if strings.Contains(list.ToMarkdown(), "Inactive") {
+		t.Error("workflows without ShadowedBy reported as inactive")
+	}
+
+	list[1].ShadowedBy = ".forgejo/workflows"
+	assertContains(t, list.ToMarkdown(), []string{"### .github/workflows/b.yml\n**Inactive**: Forgejo only reads .forgejo/workflows in this repository\n"})
+}
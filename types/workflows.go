@@ -0,0 +1,356 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Copyright © 2025 Ronmi Ren <ronmi.ren@gmail.com>
+
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WorkflowDirs are the directories Forgejo reads workflow files from, by
+// precedence: only the first one existing in the repository is used.
+var WorkflowDirs = []string{".forgejo/workflows", ".gitea/workflows", ".github/workflows"}
+
+var (
+	workflowTopKeys = []string{
+		"name", "run-name", "on", "env", "defaults", "concurrency", "jobs", "permissions",
+	}
+	workflowJobKeys = []string{
+		"name", "needs", "runs-on", "permissions", "environment", "concurrency",
+		"outputs", "env", "defaults", "if", "steps", "timeout-minutes", "strategy",
+		"continue-on-error", "container", "services", "uses", "with", "secrets",
+	}
+	yamlErrorLine = regexp.MustCompile(`line (\d+)`)
+)
+
+// WorkflowError is a problem found in a workflow file. Line is 0 when the
+// position is unknown.
+type WorkflowError struct {
+	Line    int
+	Message string
+}
+
+// WorkflowJob describes a job of a workflow.
+type WorkflowJob struct {
+	ID     string
+	Name   string
+	RunsOn []string
+	Needs  []string
+	// Uses is set for jobs calling a reusable workflow.
+	Uses string
+	Line int
+}
+
+// Workflow is the parsed summary of a workflow file.
+type Workflow struct {
+	Path     string
+	Name     string
+	Triggers []string
+	Jobs     []*WorkflowJob
+	Errors   []*WorkflowError
+	// ShadowedBy is the workflow directory Forgejo reads instead of the one
+	// of this file, which then never runs.
+	ShadowedBy string
+}
+
+// ParseWorkflow parses and validates a workflow file locally. It never fails;
+// syntax and schema problems are collected in Errors with their line numbers.
+func ParseWorkflow(path string, data []byte) *Workflow {
+	w := &Workflow{Path: path}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		line := 0
+		if m := yamlErrorLine.FindStringSubmatch(err.Error()); m != nil {
+			line, _ = strconv.Atoi(m[1])
+		}
+		w.addError(line, "invalid YAML: %s", strings.TrimPrefix(err.Error(), "yaml: "))
+		return w
+	}
+	if len(doc.Content) == 0 {
+		w.addError(0, "file is empty")
+		return w
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		w.addError(root.Line, "workflow must be a mapping")
+		return w
+	}
+
+	var on, jobs *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		switch key.Value {
+		case "name":
+			w.Name = value.Value
+		case "on":
+			on = value
+		case "jobs":
+			jobs = value
+		default:
+			if !slices.Contains(workflowTopKeys, key.Value) {
+				w.addError(key.Line, "unknown top-level key '%s'", key.Value)
+			}
+		}
+	}
+
+	if on == nil {
+		w.addError(root.Line, "missing 'on' (workflow triggers)")
+	} else {
+		w.parseTriggers(on)
+	}
+	if jobs == nil {
+		w.addError(root.Line, "missing 'jobs'")
+	} else {
+		w.parseJobs(jobs)
+	}
+
+	return w
+}
+
+func (w *Workflow) addError(line int, format string, args ...any) {
+	w.Errors = append(w.Errors, &WorkflowError{Line: line, Message: fmt.Sprintf(format, args...)})
+}
+
+func (w *Workflow) parseTriggers(on *yaml.Node) {
+	switch on.Kind {
+	case yaml.ScalarNode:
+		w.Triggers = append(w.Triggers, on.Value)
+	case yaml.SequenceNode:
+		for _, n := range on.Content {
+			if n.Kind != yaml.ScalarNode {
+				w.addError(n.Line, "trigger must be an event name")
+				continue
+			}
+			w.Triggers = append(w.Triggers, n.Value)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(on.Content); i += 2 {
+			w.Triggers = append(w.Triggers, on.Content[i].Value)
+		}
+	default:
+		w.addError(on.Line, "'on' must be an event name, a list or a mapping")
+	}
+	if len(w.Triggers) == 0 {
+		w.addError(on.Line, "no trigger defined")
+	}
+}
+
+func (w *Workflow) parseJobs(jobs *yaml.Node) {
+	if jobs.Kind != yaml.MappingNode {
+		w.addError(jobs.Line, "'jobs' must be a mapping of job IDs")
+		return
+	}
+	if len(jobs.Content) == 0 {
+		w.addError(jobs.Line, "no job defined")
+		return
+	}
+
+	needsLines := map[*WorkflowJob][]int{}
+	for i := 0; i+1 < len(jobs.Content); i += 2 {
+		key, value := jobs.Content[i], jobs.Content[i+1]
+		job := &WorkflowJob{ID: key.Value, Line: key.Line}
+		w.Jobs = append(w.Jobs, job)
+
+		if value.Kind != yaml.MappingNode {
+			w.addError(value.Line, "job '%s' must be a mapping", job.ID)
+			continue
+		}
+
+		var runsOn, steps *yaml.Node
+		for j := 0; j+1 < len(value.Content); j += 2 {
+			k, v := value.Content[j], value.Content[j+1]
+			switch k.Value {
+			case "name":
+				job.Name = v.Value
+			case "uses":
+				job.Uses = v.Value
+			case "runs-on":
+				runsOn = v
+			case "steps":
+				steps = v
+			case "needs":
+				for _, n := range scalarList(v) {
+					job.Needs = append(job.Needs, n.Value)
+					needsLines[job] = append(needsLines[job], n.Line)
+				}
+			default:
+				if !slices.Contains(workflowJobKeys, k.Value) {
+					w.addError(k.Line, "unknown key '%s' in job '%s'", k.Value, job.ID)
+				}
+			}
+		}
+
+		switch {
+		case job.Uses != "":
+			if steps != nil {
+				w.addError(steps.Line, "job '%s' calls a reusable workflow and cannot have steps", job.ID)
+			}
+		case runsOn == nil:
+			w.addError(key.Line, "job '%s' is missing 'runs-on'", job.ID)
+		default:
+			job.RunsOn = parseRunsOn(runsOn)
+			if len(job.RunsOn) == 0 {
+				w.addError(runsOn.Line, "job '%s' has empty 'runs-on'", job.ID)
+			}
+		}
+		if job.Uses == "" {
+			w.validateSteps(job, key.Line, steps)
+		}
+	}
+
+	for _, job := range w.Jobs {
+		for i, need := range job.Needs {
+			if !slices.ContainsFunc(w.Jobs, func(j *WorkflowJob) bool { return j.ID == need }) {
+				w.addError(needsLines[job][i], "job '%s' needs unknown job '%s'", job.ID, need)
+			}
+		}
+	}
+}
+
+func (w *Workflow) validateSteps(job *WorkflowJob, line int, steps *yaml.Node) {
+	if steps == nil {
+		w.addError(line, "job '%s' has no steps", job.ID)
+		return
+	}
+	if steps.Kind != yaml.SequenceNode {
+		w.addError(steps.Line, "steps of job '%s' must be a list", job.ID)
+		return
+	}
+	for _, step := range steps.Content {
+		if step.Kind != yaml.MappingNode {
+			w.addError(step.Line, "step in job '%s' must be a mapping", job.ID)
+			continue
+		}
+		var hasRun, hasUses bool
+		for j := 0; j+1 < len(step.Content); j += 2 {
+			switch step.Content[j].Value {
+			case "run":
+				hasRun = true
+			case "uses":
+				hasUses = true
+			}
+		}
+		switch {
+		case hasRun && hasUses:
+			w.addError(step.Line, "step in job '%s' cannot have both 'run' and 'uses'", job.ID)
+		case !hasRun && !hasUses:
+			w.addError(step.Line, "step in job '%s' needs 'run' or 'uses'", job.ID)
+		}
+	}
+}
+
+// parseRunsOn accepts a label, a list of labels, or a mapping with 'labels'.
+func parseRunsOn(n *yaml.Node) []string {
+	if n.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i].Value == "labels" {
+				return parseRunsOn(n.Content[i+1])
+			}
+		}
+		return nil
+	}
+
+	var ret []string
+	for _, l := range scalarList(n) {
+		if l.Value != "" {
+			ret = append(ret, l.Value)
+		}
+	}
+	return ret
+}
+
+// scalarList returns a scalar as a single item list, or the scalar items of
+// a sequence.
+func scalarList(n *yaml.Node) []*yaml.Node {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		return []*yaml.Node{n}
+	case yaml.SequenceNode:
+		ret := make([]*yaml.Node, 0, len(n.Content))
+		for _, c := range n.Content {
+			if c.Kind == yaml.ScalarNode {
+				ret = append(ret, c)
+			}
+		}
+		return ret
+	}
+	return nil
+}
+
+// ToMarkdown renders workflow with triggers, jobs and validation errors
+// Example:
+// ### .forgejo/workflows/ci.yml (CI)
+// Triggers: push, pull_request
+// - **build** runs-on: docker
+// - **test** runs-on: docker | needs: build
+//
+// Errors:
+// - line 12: job 'test' needs unknown job 'biuld'
+func (w *Workflow) ToMarkdown() string {
+	markdown := "### " + w.Path
+	if w.Name != "" {
+		markdown += " (" + w.Name + ")"
+	}
+	markdown += "\n"
+	if w.ShadowedBy != "" {
+		markdown += "**Inactive**: Forgejo only reads " + w.ShadowedBy + " in this repository\n"
+	}
+	if len(w.Triggers) > 0 {
+		markdown += "Triggers: " + strings.Join(w.Triggers, ", ") + "\n"
+	}
+	for _, job := range w.Jobs {
+		markdown += "- **" + job.ID + "**"
+		if job.Name != "" && job.Name != job.ID {
+			markdown += " (" + job.Name + ")"
+		}
+		if job.Uses != "" {
+			markdown += " uses: " + job.Uses
+		} else {
+			markdown += " runs-on: " + strings.Join(job.RunsOn, ", ")
+		}
+		if len(job.Needs) > 0 {
+			markdown += " | needs: " + strings.Join(job.Needs, ", ")
+		}
+		markdown += "\n"
+	}
+
+	if len(w.Errors) == 0 {
+		markdown += "\nNo problems found.\n"
+		return markdown
+	}
+	markdown += "\nErrors:\n"
+	for _, e := range w.Errors {
+		if e.Line > 0 {
+			markdown += fmt.Sprintf("- line %d: %s\n", e.Line, e.Message)
+		} else {
+			markdown += "- " + e.Message + "\n"
+		}
+	}
+	return markdown
+}
+
+// WorkflowList represents the workflow files of a repository.
+type WorkflowList []*Workflow
+
+// ToMarkdown renders every workflow, separated by blank lines
+func (wl WorkflowList) ToMarkdown() string {
+	if len(wl) == 0 {
+		return "*No workflow files found*"
+	}
+	parts := make([]string, 0, len(wl))
+	for _, w := range wl {
+		parts = append(parts, w.ToMarkdown())
+	}
+	return strings.Join(parts, "\n")
+}